@@ -0,0 +1,219 @@
+package main
+
+import (
+	"context"
+	"flag"
+	"log"
+	"sort"
+	"strings"
+	"time"
+
+	"skill-sync/internal/app"
+	"skill-sync/internal/config"
+	"skill-sync/internal/database/migration"
+	"skill-sync/internal/domain/job"
+	"skill-sync/internal/repository"
+
+	"github.com/google/uuid"
+)
+
+func main() {
+	target := flag.String("target", "", "what to backfill: seniority, location, salary")
+	all := flag.Bool("all", false, "reprocess every job instead of only those without a value")
+	batch := flag.Int("batch", 500, "rows per batch")
+	dryRun := flag.Bool("dry-run", false, "classify without writing")
+	flag.Parse()
+
+	cfg, err := config.Load()
+	if err != nil {
+		log.Fatalf("failed to load config: %v", err)
+	}
+
+	c, err := app.NewContainer(cfg)
+	if err != nil {
+		log.Fatalf("failed to init container: %v", err)
+	}
+	defer func() {
+		_ = c.Close()
+	}()
+
+	migCtx, migCancel := context.WithTimeout(context.Background(), 2*time.Minute)
+	defer migCancel()
+	r := migration.Runner{Dir: "migrations"}
+	if err := r.Run(migCtx, c.DB.SQLDB()); err != nil {
+		log.Fatalf("migration failed: %v", err)
+	}
+
+	ctx := context.Background()
+	switch strings.ToLower(strings.TrimSpace(*target)) {
+	case "seniority":
+		err = backfillSeniority(ctx, repository.NewPostgresSeniorityRepository(c.DB), !*all, *batch, *dryRun)
+	case "location":
+		err = backfillLocation(ctx, repository.NewPostgresJobLocationRepository(c.DB), !*all, *batch, *dryRun)
+	case "salary":
+		err = backfillSalary(ctx, repository.NewPostgresJobSalaryRepository(c.DB), !*all, *batch, *dryRun)
+	default:
+		log.Fatalf("unknown -target %q (supported: seniority, location, salary)", *target)
+	}
+	if err != nil {
+		log.Fatalf("backfill %s failed: %v", *target, err)
+	}
+}
+
+func backfillSeniority(ctx context.Context, repo repository.SeniorityRepository, onlyMissing bool, batch int, dryRun bool) error {
+	start := time.Now()
+	counts := map[string]int{}
+	scanned := 0
+	updated := 0
+
+	after := uuid.Nil
+	for {
+		rows, err := repo.ListJobsForSeniority(ctx, after, onlyMissing, batch)
+		if err != nil {
+			return err
+		}
+		if len(rows) == 0 {
+			break
+		}
+		for _, it := range rows {
+			after = it.ID
+			scanned++
+
+			level := job.ClassifySeniority(it.Title, it.Description)
+			if level == "" {
+				counts["unknown"]++
+				if onlyMissing {
+					continue
+				}
+			} else {
+				counts[level]++
+			}
+			if dryRun {
+				continue
+			}
+			if err := repo.UpdateJobSeniority(ctx, it.ID, level); err != nil {
+				log.Printf("backfill=seniority status=error job_id=%s err=%v", it.ID, err)
+				continue
+			}
+			updated++
+		}
+		log.Printf("backfill=seniority progress scanned=%d updated=%d", scanned, updated)
+	}
+
+	log.Printf("backfill=seniority status=finished scanned=%d updated=%d intern=%d junior=%d mid=%d senior=%d lead=%d unknown=%d dry_run=%t duration=%s",
+		scanned, updated,
+		counts[job.SeniorityIntern], counts[job.SeniorityJunior], counts[job.SeniorityMid],
+		counts[job.SenioritySenior], counts[job.SeniorityLead], counts["unknown"],
+		dryRun, time.Since(start))
+	return nil
+}
+
+func backfillLocation(ctx context.Context, repo repository.JobLocationRepository, onlyMissing bool, batch int, dryRun bool) error {
+	start := time.Now()
+	scanned := 0
+	resolved := 0
+	updated := 0
+	unresolved := map[string]int{}
+
+	after := uuid.Nil
+	for {
+		rows, err := repo.ListJobsForLocation(ctx, after, onlyMissing, batch)
+		if err != nil {
+			return err
+		}
+		if len(rows) == 0 {
+			break
+		}
+		for _, it := range rows {
+			after = it.ID
+			scanned++
+
+			id := job.LocationID(it.Location)
+			if id == "" {
+				unresolved[strings.ToLower(strings.TrimSpace(it.Location))]++
+				if onlyMissing {
+					continue
+				}
+			} else {
+				resolved++
+			}
+			if dryRun {
+				continue
+			}
+			if err := repo.UpdateJobLocationID(ctx, it.ID, id); err != nil {
+				log.Printf("backfill=location status=error job_id=%s err=%v", it.ID, err)
+				continue
+			}
+			updated++
+		}
+		log.Printf("backfill=location progress scanned=%d updated=%d", scanned, updated)
+	}
+
+	// The most common unrecognised strings are candidates for new aliases.
+	top := make([]string, 0, len(unresolved))
+	for loc := range unresolved {
+		top = append(top, loc)
+	}
+	sort.Slice(top, func(i, j int) bool {
+		if unresolved[top[i]] != unresolved[top[j]] {
+			return unresolved[top[i]] > unresolved[top[j]]
+		}
+		return top[i] < top[j]
+	})
+	if len(top) > 10 {
+		top = top[:10]
+	}
+	for _, loc := range top {
+		log.Printf("backfill=location unresolved=%q jobs=%d", loc, unresolved[loc])
+	}
+
+	log.Printf("backfill=location status=finished scanned=%d updated=%d resolved=%d unresolved=%d dry_run=%t duration=%s",
+		scanned, updated, resolved, scanned-resolved, dryRun, time.Since(start))
+	return nil
+}
+
+func backfillSalary(ctx context.Context, repo repository.JobSalaryRepository, onlyMissing bool, batch int, dryRun bool) error {
+	start := time.Now()
+	scanned := 0
+	parsed := 0
+	updated := 0
+	currencies := map[string]int{}
+
+	after := uuid.Nil
+	for {
+		rows, err := repo.ListJobsForSalary(ctx, after, onlyMissing, batch)
+		if err != nil {
+			return err
+		}
+		if len(rows) == 0 {
+			break
+		}
+		for _, it := range rows {
+			after = it.ID
+			scanned++
+
+			salary, ok := job.JobSalary(it.Title, it.Description)
+			if ok {
+				parsed++
+				currencies[salary.Currency]++
+			} else if onlyMissing {
+				continue
+			}
+			if dryRun {
+				continue
+			}
+			if err := repo.UpdateJobSalary(ctx, it.ID, salary); err != nil {
+				log.Printf("backfill=salary status=error job_id=%s err=%v", it.ID, err)
+				continue
+			}
+			updated++
+		}
+		log.Printf("backfill=salary progress scanned=%d updated=%d", scanned, updated)
+	}
+
+	log.Printf("backfill=salary status=finished scanned=%d updated=%d parsed=%d idr=%d usd=%d sgd=%d eur=%d dry_run=%t duration=%s",
+		scanned, updated, parsed,
+		currencies["IDR"], currencies["USD"], currencies["SGD"], currencies["EUR"],
+		dryRun, time.Since(start))
+	return nil
+}
@@ -18,6 +18,30 @@ type Config struct {
 
 	SearchFreshnessMinutes int
 	ScraperBaseURL         string
+
+	MatchingProfile string
+	AdminEmails     []string
+
+	RecommendationMatchTTLMinutes int
+	SavedSearchAlertMinutes       int
+	SearchSynonymReloadSeconds    int
+	SearchVocabularyReloadMinutes int
+	SearchSuggestRefreshSeconds   int
+
+	Ranking RankingConfig
+}
+
+// RankingConfig weights the components of a /jobs ranking score.
+type RankingConfig struct {
+	RelevanceWeight float64
+	TextRankWeight  float64
+	FreshnessWeight float64
+	SourceWeight    float64
+	QualityWeight   float64
+	// MatchWeight applies to the user's skill match on personalised searches.
+	MatchWeight float64
+	// ProximityWeight applies to the distance from the centre of near= searches.
+	ProximityWeight float64
 }
 
 type AppConfig struct {
@@ -118,6 +142,22 @@ func Load() (Config, error) {
 
 	cfg.SearchFreshnessMinutes = optInt("SEARCH_FRESHNESS_MINUTES", 30)
 	cfg.ScraperBaseURL = opt("SCRAPER_BASE_URL")
+	cfg.MatchingProfile = opt("MATCHING_PROFILE")
+	cfg.AdminEmails = optList("ADMIN_EMAILS")
+	cfg.RecommendationMatchTTLMinutes = optInt("RECOMMENDATION_MATCH_TTL_MINUTES", 1440)
+	cfg.SavedSearchAlertMinutes = optInt("SAVED_SEARCH_ALERT_MINUTES", 5)
+	cfg.SearchSynonymReloadSeconds = optInt("SEARCH_SYNONYM_RELOAD_SECONDS", 30)
+	cfg.SearchVocabularyReloadMinutes = optInt("SEARCH_VOCABULARY_RELOAD_MINUTES", 30)
+	cfg.SearchSuggestRefreshSeconds = optInt("SEARCH_SUGGEST_REFRESH_SECONDS", 60)
+	cfg.Ranking = RankingConfig{
+		RelevanceWeight: optFloat("RANKING_WEIGHT_RELEVANCE", 2.0),
+		TextRankWeight:  optFloat("RANKING_WEIGHT_TEXT_RANK", 1.5),
+		FreshnessWeight: optFloat("RANKING_WEIGHT_FRESHNESS", 1.5),
+		SourceWeight:    optFloat("RANKING_WEIGHT_SOURCE", 1.0),
+		QualityWeight:   optFloat("RANKING_WEIGHT_QUALITY", 0.5),
+		MatchWeight:     optFloat("RANKING_WEIGHT_MATCH", 2.0),
+		ProximityWeight: optFloat("RANKING_WEIGHT_PROXIMITY", 1.5),
+	}
 
 	if len(missing) > 0 {
 		return Config{}, fmt.Errorf("%w: %s", errMissingRequiredEnv, strings.Join(missing, ", "))
@@ -141,6 +181,38 @@ func optInt(key string, defaultVal int) int {
 	return v
 }
 
+// optFloat allows zero so a ranking component can be switched off.
+func optFloat(key string, defaultVal float64) float64 {
+	raw := strings.TrimSpace(os.Getenv(key))
+	if raw == "" {
+		return defaultVal
+	}
+	v, err := strconv.ParseFloat(raw, 64)
+	if err != nil {
+		return defaultVal
+	}
+	if v < 0 {
+		return defaultVal
+	}
+	return v
+}
+
+func optList(key string) []string {
+	raw := strings.TrimSpace(os.Getenv(key))
+	if raw == "" {
+		return nil
+	}
+	out := make([]string, 0)
+	for _, part := range strings.Split(raw, ",") {
+		part = strings.TrimSpace(part)
+		if part == "" {
+			continue
+		}
+		out = append(out, part)
+	}
+	return out
+}
+
 func optInt32(key string) int32 {
 	raw := strings.TrimSpace(os.Getenv(key))
 	if raw == "" {
@@ -12,7 +12,7 @@ type JobSourcesSeeder struct{}
 func (JobSourcesSeeder) Name() string { return "job_sources" }
 
 func (JobSourcesSeeder) Run(ctx context.Context, db database.DB) error {
-	if err := EnsureTableColumns(ctx, db, "job_sources", "id", "name", "base_url", "created_at"); err != nil {
+	if err := EnsureTableColumns(ctx, db, "job_sources", "id", "name", "base_url", "ranking_weight", "created_at"); err != nil {
 		return err
 	}
 
@@ -25,23 +25,25 @@ func (JobSourcesSeeder) Run(ctx context.Context, db database.DB) error {
 	}()
 
 	items := []struct {
-		Name    string
-		BaseURL string
+		Name          string
+		BaseURL       string
+		RankingWeight float64
 	}{
-		{Name: "LinkedIn", BaseURL: "https://www.linkedin.com/jobs"},
-		{Name: "JobStreet", BaseURL: "https://www.jobstreet.co.id"},
-		{Name: "Dev.to Jobs", BaseURL: "https://dev.to"},
-		{Name: "Glints", BaseURL: "https://glints.com"},
-		{Name: "Kalibrr", BaseURL: "https://www.kalibrr.com"},
-		{Name: "Indeed", BaseURL: "https://www.indeed.com"},
+		{Name: "LinkedIn", BaseURL: "https://www.linkedin.com/jobs", RankingWeight: 3},
+		{Name: "JobStreet", BaseURL: "https://www.jobstreet.co.id", RankingWeight: 3},
+		{Name: "Dev.to Jobs", BaseURL: "https://dev.to", RankingWeight: 2},
+		{Name: "Glints", BaseURL: "https://glints.com", RankingWeight: 3},
+		{Name: "Kalibrr", BaseURL: "https://www.kalibrr.com", RankingWeight: 3},
+		{Name: "Indeed", BaseURL: "https://www.indeed.com", RankingWeight: 3},
 	}
 
 	for _, it := range items {
 		_, err := tx.Exec(
 			ctx,
-			`INSERT INTO job_sources (id, name, base_url) VALUES (gen_random_uuid(), $1, $2) ON CONFLICT (name) DO NOTHING`,
+			`INSERT INTO job_sources (id, name, base_url, ranking_weight) VALUES (gen_random_uuid(), $1, $2, $3) ON CONFLICT (name) DO NOTHING`,
 			it.Name,
 			it.BaseURL,
+			it.RankingWeight,
 		)
 		if err != nil {
 			return err
@@ -0,0 +1,44 @@
+package dto
+
+import (
+	"time"
+
+	"github.com/google/uuid"
+)
+
+type ApplicationResponse struct {
+	ID                uuid.UUID          `json:"id"`
+	JobID             uuid.UUID          `json:"job_id"`
+	Title             string             `json:"title"`
+	CompanyName       string             `json:"company_name"`
+	Location          string             `json:"location"`
+	Stage             string             `json:"stage"`
+	Notes             string             `json:"notes"`
+	Contact           ApplicationContact `json:"contact"`
+	InterviewAt       *time.Time         `json:"interview_at"`
+	SavedMatchScore   *int               `json:"saved_match_score"`
+	CurrentMatchScore *int               `json:"current_match_score"`
+	ScoreChange       *int               `json:"score_change"`
+	AppliedAt         *time.Time         `json:"applied_at"`
+	StageChangedAt    time.Time          `json:"stage_changed_at"`
+	CreatedAt         time.Time          `json:"created_at"`
+	UpdatedAt         time.Time          `json:"updated_at"`
+}
+
+type ApplicationContact struct {
+	Name  string `json:"name"`
+	Email string `json:"email"`
+	Phone string `json:"phone"`
+}
+
+type ApplicationStageEventResponse struct {
+	FromStage string    `json:"from_stage,omitempty"`
+	ToStage   string    `json:"to_stage"`
+	Note      string    `json:"note,omitempty"`
+	CreatedAt time.Time `json:"created_at"`
+}
+
+type ApplicationSummaryResponse struct {
+	Total   int            `json:"total"`
+	ByStage map[string]int `json:"by_stage"`
+}
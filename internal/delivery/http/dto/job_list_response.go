@@ -10,5 +10,78 @@ type JobListResponse struct {
 	SourceURL   string    `json:"source_url"`
 	Description string    `json:"description"`
 	Skills      []string  `json:"skills"`
+	Seniority   string    `json:"seniority,omitempty"`
 	PostedDate  string    `json:"posted_date"`
+	// MatchScore and MandatoryMissing are only present with personalize=true,
+	// for jobs with skill requirements.
+	MatchScore       *int  `json:"match_score,omitempty"`
+	MandatoryMissing *bool `json:"mandatory_missing,omitempty"`
+	// DistanceKm is only present with near=.
+	DistanceKm *float64 `json:"distance_km,omitempty"`
+	// Salary is only present when the posting states one.
+	Salary *JobSalaryResponse `json:"salary,omitempty"`
+	// Score is only present with explain=true.
+	Score *JobScoreResponse `json:"score,omitempty"`
+}
+
+// JobSalaryResponse gives the salary range in IDR per month, with the
+// currency and pay period the posting used.
+type JobSalaryResponse struct {
+	MinMonthlyIDR int    `json:"min_monthly_idr"`
+	MaxMonthlyIDR int    `json:"max_monthly_idr"`
+	Currency      string `json:"currency"`
+	Period        string `json:"period"`
+}
+
+// JobScoreResponse breaks down a job's ranking score; FinalScore is the
+// weighted sum of the other components.
+type JobScoreResponse struct {
+	Relevance     float64 `json:"relevance"`
+	TextRank      float64 `json:"text_rank"`
+	Freshness     float64 `json:"freshness"`
+	SourceQuality float64 `json:"source_quality"`
+	DataQuality   float64 `json:"data_quality"`
+	Match         float64 `json:"match,omitempty"`
+	Proximity     float64 `json:"proximity,omitempty"`
+	FinalScore    float64 `json:"final_score"`
+}
+
+type JobListMeta struct {
+	// DidYouMean is a spelling suggestion for a query that found few jobs.
+	DidYouMean string `json:"did_you_mean,omitempty"`
+	// CorrectionApplied is true when the jobs returned are for DidYouMean
+	// rather than for the query as typed.
+	CorrectionApplied bool   `json:"correction_applied"`
+	CorrectedQuery    string `json:"corrected_query,omitempty"`
+	FuzzyMatch        bool   `json:"fuzzy_match,omitempty"`
+	// DetectedLocation is the place read from the query and applied as the
+	// location filter.
+	DetectedLocation string `json:"detected_location,omitempty"`
+	// NextCursor and PrevCursor are opaque tokens for the cursor parameter.
+	NextCursor string `json:"next_cursor,omitempty"`
+	PrevCursor string `json:"prev_cursor,omitempty"`
+	// Total is only present with total=exact or total=estimate.
+	Total          *int `json:"total,omitempty"`
+	TotalEstimated bool `json:"total_estimated,omitempty"`
+	// Facets maps a facet name (location, company, source, skill,
+	// employment_type, posted) to its value counts; only set with facets=true.
+	Facets map[string][]JobFacetValue `json:"facets,omitempty"`
+}
+
+type JobFacetValue struct {
+	Value string `json:"value"`
+	Count int    `json:"count"`
+}
+
+type JobSuggestion struct {
+	Text  string `json:"text"`
+	Count int    `json:"count"`
+}
+
+type JobSuggestResponse struct {
+	Query     string          `json:"query"`
+	Titles    []JobSuggestion `json:"titles"`
+	Skills    []JobSuggestion `json:"skills"`
+	Companies []JobSuggestion `json:"companies"`
+	Locations []JobSuggestion `json:"locations"`
 }
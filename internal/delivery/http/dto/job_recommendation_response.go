@@ -10,6 +10,9 @@ type JobRecommendationResponse struct {
 	MatchScore       int                                `json:"match_score"`
 	MandatoryMissing bool                               `json:"mandatory_missing"`
 	MissingSkills    []JobRecommendationMissingSkillItem `json:"missing_skills"`
+	Rank             int                                `json:"rank"`
+	OriginalRank     int                                `json:"original_rank"`
+	RerankReason     string                             `json:"rerank_reason,omitempty"`
 }
 
 type JobRecommendationMissingSkillItem struct {
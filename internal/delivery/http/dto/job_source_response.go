@@ -0,0 +1,16 @@
+package dto
+
+import (
+	"time"
+
+	"github.com/google/uuid"
+)
+
+type JobSourceResponse struct {
+	ID            uuid.UUID `json:"id"`
+	Name          string    `json:"name"`
+	BaseURL       string    `json:"base_url"`
+	RankingWeight float64   `json:"ranking_weight"`
+	ActiveJobs    int       `json:"active_jobs"`
+	CreatedAt     time.Time `json:"created_at"`
+}
@@ -9,14 +9,48 @@ type MatchSkillResponseV2 struct {
 }
 
 type MissingSkillResponseV2 struct {
-	SkillID     uuid.UUID `json:"skill_id"`
-	SkillName   string    `json:"skill_name"`
-	IsMandatory bool      `json:"is_mandatory"`
+	SkillID     uuid.UUID              `json:"skill_id"`
+	SkillName   string                 `json:"skill_name"`
+	IsMandatory bool                   `json:"is_mandatory"`
+	CreditedBy  *SkillCreditResponseV2 `json:"credited_by,omitempty"`
+}
+
+type SkillCreditResponseV2 struct {
+	SkillID           uuid.UUID `json:"skill_id"`
+	SkillName         string    `json:"skill_name"`
+	Relation          string    `json:"relation"`
+	Credit            float64   `json:"credit"`
+	ScoreContribution int       `json:"score_contribution"`
 }
 
 type MatchingResultResponseV2 struct {
-	MatchScore       int                     `json:"match_score"`
-	MandatoryMissing bool                    `json:"mandatory_missing"`
-	MatchedSkills    []MatchSkillResponseV2  `json:"matched_skills"`
+	MatchScore       int                      `json:"match_score"`
+	MandatoryMissing bool                     `json:"mandatory_missing"`
+	MatchedSkills    []MatchSkillResponseV2   `json:"matched_skills"`
 	MissingSkills    []MissingSkillResponseV2 `json:"missing_skills"`
+	Breakdown        ScoreBreakdownResponseV2 `json:"breakdown"`
+	SeniorityGap     int                      `json:"seniority_gap"`
+	ScoringProfile   string                   `json:"scoring_profile"`
+	ScoringVersion   int                      `json:"scoring_version"`
+}
+
+type ScoreBreakdownResponseV2 struct {
+	Mandatory  float64 `json:"mandatory"`
+	Optional   float64 `json:"optional"`
+	Experience float64 `json:"experience"`
+	Seniority  float64 `json:"seniority"`
+}
+
+type MatchDeltaResponseV2 struct {
+	MatchScore int     `json:"match_score"`
+	Mandatory  float64 `json:"mandatory"`
+	Optional   float64 `json:"optional"`
+	Experience float64 `json:"experience"`
+	Seniority  float64 `json:"seniority"`
+}
+
+type MatchSimulationResponseV2 struct {
+	Current   MatchingResultResponseV2 `json:"current"`
+	Simulated MatchingResultResponseV2 `json:"simulated"`
+	Delta     MatchDeltaResponseV2     `json:"delta"`
 }
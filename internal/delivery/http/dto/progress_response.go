@@ -0,0 +1,45 @@
+package dto
+
+import (
+	"time"
+
+	"github.com/google/uuid"
+)
+
+type ProgressResponse struct {
+	TopN                int                   `json:"top_n"`
+	Days                int                   `json:"days"`
+	CurrentAverage      float64               `json:"current_average"`
+	Change              float64               `json:"change"`
+	Points              []ProgressPoint       `json:"points"`
+	PendingSkillChanges []SkillChangeResponse `json:"pending_skill_changes"`
+}
+
+type ProgressPoint struct {
+	RecordedAt     time.Time             `json:"recorded_at"`
+	TopAverage     float64               `json:"top_average"`
+	MatchedJobs    int                   `json:"matched_jobs"`
+	Change         float64               `json:"change"`
+	ScoringVersion int                   `json:"scoring_version"`
+	ScoringChanged bool                  `json:"scoring_changed"`
+	SkillChanges   []SkillChangeResponse `json:"skill_changes"`
+}
+
+type SkillChangeResponse struct {
+	SkillID        uuid.UUID `json:"skill_id"`
+	SkillName      string    `json:"skill_name"`
+	Action         string    `json:"action"`
+	OldProficiency *int      `json:"old_proficiency,omitempty"`
+	NewProficiency *int      `json:"new_proficiency,omitempty"`
+	OldYears       *int      `json:"old_years,omitempty"`
+	NewYears       *int      `json:"new_years,omitempty"`
+	ChangedAt      time.Time `json:"changed_at"`
+}
+
+type MatchHistoryResponse struct {
+	MatchScore     float64                  `json:"match_score"`
+	Breakdown      ScoreBreakdownResponseV2 `json:"breakdown"`
+	ScoringProfile string                   `json:"scoring_profile"`
+	ScoringVersion int                      `json:"scoring_version"`
+	RecordedAt     time.Time                `json:"recorded_at"`
+}
@@ -0,0 +1,35 @@
+package dto
+
+import (
+	"time"
+
+	"github.com/google/uuid"
+)
+
+type SavedSearchResponse struct {
+	ID            uuid.UUID        `json:"id"`
+	Name          string           `json:"name"`
+	Query         SavedSearchQuery `json:"query"`
+	Notify        string           `json:"notify"`
+	UnseenMatches int              `json:"unseen_matches"`
+	LastMatchedAt *time.Time       `json:"last_matched_at"`
+	CreatedAt     time.Time        `json:"created_at"`
+	UpdatedAt     time.Time        `json:"updated_at"`
+}
+
+type SavedSearchQuery struct {
+	Title       string   `json:"title"`
+	CompanyName string   `json:"company_name"`
+	Location    string   `json:"location"`
+	Skills      []string `json:"skills"`
+	Seniority   []string `json:"seniority,omitempty"`
+}
+
+type SavedSearchMatchResponse struct {
+	JobID       uuid.UUID  `json:"job_id"`
+	Title       string     `json:"title"`
+	CompanyName string     `json:"company_name"`
+	Location    string     `json:"location"`
+	MatchedAt   time.Time  `json:"matched_at"`
+	SeenAt      *time.Time `json:"seen_at"`
+}
@@ -0,0 +1,17 @@
+package dto
+
+import (
+	"time"
+
+	"github.com/google/uuid"
+)
+
+type SearchSynonymResponse struct {
+	ID        uuid.UUID `json:"id"`
+	Language  string    `json:"language"`
+	Term      string    `json:"term"`
+	Synonyms  []string  `json:"synonyms"`
+	TwoWay    bool      `json:"two_way"`
+	CreatedAt time.Time `json:"created_at"`
+	UpdatedAt time.Time `json:"updated_at"`
+}
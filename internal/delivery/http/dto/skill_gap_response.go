@@ -0,0 +1,30 @@
+package dto
+
+import "github.com/google/uuid"
+
+type SkillGapJobResponse struct {
+	JobID            uuid.UUID `json:"job_id"`
+	Title            string    `json:"title"`
+	CompanyName      string    `json:"company_name"`
+	CurrentScore     int       `json:"current_score"`
+	SimulatedScore   int       `json:"simulated_score"`
+	MandatoryCleared bool      `json:"mandatory_cleared"`
+	CrossedThreshold bool      `json:"crossed_threshold"`
+}
+
+type SkillGapItemResponse struct {
+	Rank         int                   `json:"rank"`
+	SkillID      uuid.UUID             `json:"skill_id"`
+	SkillName    string                `json:"skill_name"`
+	TotalPoints  int                   `json:"total_points"`
+	JobsAffected int                   `json:"jobs_affected"`
+	JobsUnlocked int                   `json:"jobs_unlocked"`
+	MandatoryIn  int                   `json:"mandatory_in"`
+	Jobs         []SkillGapJobResponse `json:"jobs"`
+}
+
+type SkillGapPlanResponse struct {
+	JobsConsidered int                    `json:"jobs_considered"`
+	Threshold      int                    `json:"threshold"`
+	Skills         []SkillGapItemResponse `json:"skills"`
+}
@@ -0,0 +1,19 @@
+package dto
+
+import (
+	"time"
+
+	"github.com/google/uuid"
+)
+
+type SkillRelationResponse struct {
+	ID               uuid.UUID `json:"id"`
+	SkillID          uuid.UUID `json:"skill_id"`
+	SkillName        string    `json:"skill_name"`
+	RelatedSkillID   uuid.UUID `json:"related_skill_id"`
+	RelatedSkillName string    `json:"related_skill_name"`
+	RelationType     string    `json:"relation_type"`
+	Credit           float64   `json:"credit"`
+	CreatedAt        time.Time `json:"created_at"`
+	UpdatedAt        time.Time `json:"updated_at"`
+}
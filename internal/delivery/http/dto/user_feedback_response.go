@@ -0,0 +1,28 @@
+package dto
+
+import (
+	"time"
+
+	"github.com/google/uuid"
+)
+
+type DismissedJobResponse struct {
+	JobID       uuid.UUID `json:"job_id"`
+	Title       string    `json:"title"`
+	CompanyName string    `json:"company_name"`
+	Location    string    `json:"location"`
+	Reason      string    `json:"reason"`
+	Note        string    `json:"note,omitempty"`
+	DismissedAt time.Time `json:"dismissed_at"`
+}
+
+type BlockedCompanyResponse struct {
+	ID          uuid.UUID `json:"id"`
+	CompanyName string    `json:"company_name"`
+	BlockedAt   time.Time `json:"blocked_at"`
+}
+
+type DismissalReasonStatResponse struct {
+	Reason string `json:"reason"`
+	Count  int    `json:"count"`
+}
@@ -0,0 +1,93 @@
+package handler
+
+import (
+	"errors"
+	"strings"
+
+	"skill-sync/internal/delivery/http/dto"
+	"skill-sync/internal/delivery/http/middleware"
+	"skill-sync/internal/pkg/response"
+	"skill-sync/internal/repository"
+	"skill-sync/internal/usecase"
+
+	"github.com/gofiber/fiber/v3"
+	"github.com/google/uuid"
+)
+
+type AdminJobSourceHandler struct {
+	uc usecase.JobSourceUsecase
+}
+
+type jobSourceWeightRequest struct {
+	RankingWeight float64 `json:"ranking_weight"`
+}
+
+func NewAdminJobSourceHandler(uc usecase.JobSourceUsecase) *AdminJobSourceHandler {
+	return &AdminJobSourceHandler{uc: uc}
+}
+
+func (h *AdminJobSourceHandler) RegisterRoutes(r fiber.Router) {
+	if r == nil {
+		return
+	}
+
+	grp := r.Group("/job-sources")
+	grp.Get("/", h.List)
+	grp.Put("/:id/ranking-weight", h.UpdateRankingWeight)
+}
+
+func (h *AdminJobSourceHandler) List(c fiber.Ctx) error {
+	items, err := h.uc.ListSources(c.Context())
+	if err != nil {
+		return mapJobSourceUsecaseError(err)
+	}
+
+	res := make([]dto.JobSourceResponse, 0, len(items))
+	for _, it := range items {
+		res = append(res, toJobSourceResponse(it))
+	}
+	return response.Success(c, fiber.StatusOK, response.MessageOK, res)
+}
+
+func (h *AdminJobSourceHandler) UpdateRankingWeight(c fiber.Ctx) error {
+	id, err := uuid.Parse(strings.TrimSpace(c.Params("id")))
+	if err != nil {
+		return middleware.NewAppError(fiber.StatusBadRequest, "Invalid job source id", nil, err)
+	}
+	var req jobSourceWeightRequest
+	if err := c.Bind().Body(&req); err != nil {
+		return middleware.NewAppError(fiber.StatusBadRequest, "Bad request", nil, err)
+	}
+
+	out, err := h.uc.UpdateRankingWeight(c.Context(), id, req.RankingWeight)
+	if err != nil {
+		return mapJobSourceUsecaseError(err)
+	}
+	return response.Success(c, fiber.StatusOK, "Ranking weight saved successfully", toJobSourceResponse(out))
+}
+
+func toJobSourceResponse(it repository.JobSource) dto.JobSourceResponse {
+	return dto.JobSourceResponse{
+		ID:            it.ID,
+		Name:          it.Name,
+		BaseURL:       it.BaseURL,
+		RankingWeight: it.RankingWeight,
+		ActiveJobs:    it.ActiveJobs,
+		CreatedAt:     it.CreatedAt,
+	}
+}
+
+func mapJobSourceUsecaseError(err error) error {
+	if err == nil {
+		return nil
+	}
+
+	switch {
+	case errors.Is(err, usecase.ErrInvalidInput):
+		return middleware.NewAppError(fiber.StatusBadRequest, "Bad request", nil, err)
+	case errors.Is(err, usecase.ErrJobSourceNotFound):
+		return middleware.NewAppError(fiber.StatusNotFound, "Job source not found", nil, err)
+	default:
+		return middleware.NewAppError(fiber.StatusInternalServerError, response.MessageInternalServerError, nil, err)
+	}
+}
@@ -0,0 +1,135 @@
+package handler
+
+import (
+	"errors"
+	"strings"
+
+	"skill-sync/internal/delivery/http/dto"
+	"skill-sync/internal/delivery/http/middleware"
+	"skill-sync/internal/pkg/response"
+	"skill-sync/internal/repository"
+	"skill-sync/internal/usecase"
+
+	"github.com/gofiber/fiber/v3"
+	"github.com/google/uuid"
+)
+
+type AdminSearchSynonymHandler struct {
+	uc usecase.SearchSynonymUsecase
+}
+
+type searchSynonymRequest struct {
+	Language string   `json:"language"`
+	Term     string   `json:"term"`
+	Synonyms []string `json:"synonyms"`
+	TwoWay   bool     `json:"two_way"`
+}
+
+func NewAdminSearchSynonymHandler(uc usecase.SearchSynonymUsecase) *AdminSearchSynonymHandler {
+	return &AdminSearchSynonymHandler{uc: uc}
+}
+
+func (h *AdminSearchSynonymHandler) RegisterRoutes(r fiber.Router) {
+	if r == nil {
+		return
+	}
+
+	grp := r.Group("/search-synonyms")
+	grp.Get("/", h.List)
+	grp.Post("/", h.Create)
+	grp.Put("/:id", h.Update)
+	grp.Delete("/:id", h.Delete)
+}
+
+func (h *AdminSearchSynonymHandler) List(c fiber.Ctx) error {
+	items, err := h.uc.ListSynonyms(c.Context())
+	if err != nil {
+		return mapSearchSynonymUsecaseError(err)
+	}
+
+	res := make([]dto.SearchSynonymResponse, 0, len(items))
+	for _, it := range items {
+		res = append(res, toSearchSynonymResponse(it))
+	}
+	return response.Success(c, fiber.StatusOK, response.MessageOK, res)
+}
+
+func (h *AdminSearchSynonymHandler) Create(c fiber.Ctx) error {
+	var req searchSynonymRequest
+	if err := c.Bind().Body(&req); err != nil {
+		return middleware.NewAppError(fiber.StatusBadRequest, "Bad request", nil, err)
+	}
+
+	out, err := h.uc.CreateSynonym(c.Context(), req.toInput())
+	if err != nil {
+		return mapSearchSynonymUsecaseError(err)
+	}
+	return response.Success(c, fiber.StatusCreated, "Synonym saved successfully", toSearchSynonymResponse(out))
+}
+
+func (h *AdminSearchSynonymHandler) Update(c fiber.Ctx) error {
+	id, err := uuid.Parse(strings.TrimSpace(c.Params("id")))
+	if err != nil {
+		return middleware.NewAppError(fiber.StatusBadRequest, "Invalid synonym id", nil, err)
+	}
+	var req searchSynonymRequest
+	if err := c.Bind().Body(&req); err != nil {
+		return middleware.NewAppError(fiber.StatusBadRequest, "Bad request", nil, err)
+	}
+
+	out, err := h.uc.UpdateSynonym(c.Context(), id, req.toInput())
+	if err != nil {
+		return mapSearchSynonymUsecaseError(err)
+	}
+	return response.Success(c, fiber.StatusOK, "Synonym saved successfully", toSearchSynonymResponse(out))
+}
+
+func (h *AdminSearchSynonymHandler) Delete(c fiber.Ctx) error {
+	id, err := uuid.Parse(strings.TrimSpace(c.Params("id")))
+	if err != nil {
+		return middleware.NewAppError(fiber.StatusBadRequest, "Invalid synonym id", nil, err)
+	}
+
+	if err := h.uc.DeleteSynonym(c.Context(), id); err != nil {
+		return mapSearchSynonymUsecaseError(err)
+	}
+	return response.Success(c, fiber.StatusOK, "Synonym deleted successfully", nil)
+}
+
+func (r searchSynonymRequest) toInput() usecase.SearchSynonymInput {
+	return usecase.SearchSynonymInput{
+		Language: r.Language,
+		Term:     r.Term,
+		Synonyms: r.Synonyms,
+		TwoWay:   r.TwoWay,
+	}
+}
+
+func toSearchSynonymResponse(it repository.SearchSynonym) dto.SearchSynonymResponse {
+	return dto.SearchSynonymResponse{
+		ID:        it.ID,
+		Language:  it.Language,
+		Term:      it.Term,
+		Synonyms:  it.Synonyms,
+		TwoWay:    it.TwoWay,
+		CreatedAt: it.CreatedAt,
+		UpdatedAt: it.UpdatedAt,
+	}
+}
+
+func mapSearchSynonymUsecaseError(err error) error {
+	if err == nil {
+		return nil
+	}
+
+	switch {
+	case errors.Is(err, usecase.ErrInvalidInput):
+		return middleware.NewAppError(fiber.StatusBadRequest, "Bad request", nil, err)
+	case errors.Is(err, usecase.ErrSynonymNotFound):
+		return middleware.NewAppError(fiber.StatusNotFound, "Synonym not found", nil, err)
+	case errors.Is(err, usecase.ErrSynonymExists):
+		return middleware.NewAppError(fiber.StatusConflict, "Synonym already exists", nil, err)
+	default:
+		return middleware.NewAppError(fiber.StatusInternalServerError, response.MessageInternalServerError, nil, err)
+	}
+}
@@ -0,0 +1,115 @@
+package handler
+
+import (
+	"errors"
+	"strings"
+
+	"skill-sync/internal/delivery/http/dto"
+	"skill-sync/internal/delivery/http/middleware"
+	"skill-sync/internal/pkg/response"
+	"skill-sync/internal/repository"
+	"skill-sync/internal/usecase"
+
+	"github.com/gofiber/fiber/v3"
+	"github.com/google/uuid"
+)
+
+type AdminSkillRelationHandler struct {
+	uc usecase.SkillRelationUsecase
+}
+
+type upsertSkillRelationRequest struct {
+	SkillID        uuid.UUID `json:"skill_id"`
+	RelatedSkillID uuid.UUID `json:"related_skill_id"`
+	RelationType   string    `json:"relation_type"`
+	Credit         float64   `json:"credit"`
+}
+
+func NewAdminSkillRelationHandler(uc usecase.SkillRelationUsecase) *AdminSkillRelationHandler {
+	return &AdminSkillRelationHandler{uc: uc}
+}
+
+func (h *AdminSkillRelationHandler) RegisterRoutes(r fiber.Router) {
+	if r == nil {
+		return
+	}
+
+	grp := r.Group("/skill-relations")
+	grp.Get("/", h.List)
+	grp.Post("/", h.Upsert)
+	grp.Delete("/:id", h.Delete)
+}
+
+func (h *AdminSkillRelationHandler) List(c fiber.Ctx) error {
+	items, err := h.uc.ListRelations(c.Context())
+	if err != nil {
+		return mapSkillRelationUsecaseError(err)
+	}
+
+	res := make([]dto.SkillRelationResponse, 0, len(items))
+	for _, it := range items {
+		res = append(res, toSkillRelationResponse(it))
+	}
+	return response.Success(c, fiber.StatusOK, response.MessageOK, res)
+}
+
+func (h *AdminSkillRelationHandler) Upsert(c fiber.Ctx) error {
+	var req upsertSkillRelationRequest
+	if err := c.Bind().Body(&req); err != nil {
+		return middleware.NewAppError(fiber.StatusBadRequest, "Bad request", nil, err)
+	}
+
+	out, err := h.uc.UpsertRelation(c.Context(), usecase.SkillRelationInput{
+		SkillID:        req.SkillID,
+		RelatedSkillID: req.RelatedSkillID,
+		RelationType:   req.RelationType,
+		Credit:         req.Credit,
+	})
+	if err != nil {
+		return mapSkillRelationUsecaseError(err)
+	}
+	return response.Success(c, fiber.StatusOK, "Skill relation saved successfully", toSkillRelationResponse(out))
+}
+
+func (h *AdminSkillRelationHandler) Delete(c fiber.Ctx) error {
+	id, err := uuid.Parse(strings.TrimSpace(c.Params("id")))
+	if err != nil {
+		return middleware.NewAppError(fiber.StatusBadRequest, "Invalid relation id", nil, err)
+	}
+
+	if err := h.uc.DeleteRelation(c.Context(), id); err != nil {
+		return mapSkillRelationUsecaseError(err)
+	}
+	return response.Success(c, fiber.StatusOK, "Skill relation deleted successfully", nil)
+}
+
+func toSkillRelationResponse(it repository.SkillRelation) dto.SkillRelationResponse {
+	return dto.SkillRelationResponse{
+		ID:               it.ID,
+		SkillID:          it.SkillID,
+		SkillName:        it.SkillName,
+		RelatedSkillID:   it.RelatedSkillID,
+		RelatedSkillName: it.RelatedSkillName,
+		RelationType:     it.RelationType,
+		Credit:           it.Credit,
+		CreatedAt:        it.CreatedAt,
+		UpdatedAt:        it.UpdatedAt,
+	}
+}
+
+func mapSkillRelationUsecaseError(err error) error {
+	if err == nil {
+		return nil
+	}
+
+	switch {
+	case errors.Is(err, usecase.ErrInvalidInput):
+		return middleware.NewAppError(fiber.StatusBadRequest, "Bad request", nil, err)
+	case errors.Is(err, usecase.ErrSkillNotFound):
+		return middleware.NewAppError(fiber.StatusNotFound, "Skill not found", nil, err)
+	case errors.Is(err, usecase.ErrSkillRelationNotFound):
+		return middleware.NewAppError(fiber.StatusNotFound, "Skill relation not found", nil, err)
+	default:
+		return middleware.NewAppError(fiber.StatusInternalServerError, response.MessageInternalServerError, nil, err)
+	}
+}
@@ -0,0 +1,283 @@
+package handler
+
+import (
+	"errors"
+	"strings"
+	"time"
+
+	"skill-sync/internal/delivery/http/dto"
+	"skill-sync/internal/delivery/http/middleware"
+	"skill-sync/internal/pkg/response"
+	"skill-sync/internal/usecase"
+
+	"github.com/gofiber/fiber/v3"
+	"github.com/google/uuid"
+)
+
+type ApplicationHandler struct {
+	uc usecase.ApplicationUsecase
+}
+
+type createApplicationRequest struct {
+	JobID        uuid.UUID `json:"job_id"`
+	Stage        string    `json:"stage"`
+	Notes        string    `json:"notes"`
+	ContactName  string    `json:"contact_name"`
+	ContactEmail string    `json:"contact_email"`
+	ContactPhone string    `json:"contact_phone"`
+	InterviewAt  string    `json:"interview_at"`
+}
+
+// updateApplicationRequest leaves omitted fields untouched; an empty
+// interview_at clears the date.
+type updateApplicationRequest struct {
+	Stage        *string `json:"stage"`
+	StageNote    string  `json:"stage_note"`
+	Notes        *string `json:"notes"`
+	ContactName  *string `json:"contact_name"`
+	ContactEmail *string `json:"contact_email"`
+	ContactPhone *string `json:"contact_phone"`
+	InterviewAt  *string `json:"interview_at"`
+}
+
+func NewApplicationHandler(uc usecase.ApplicationUsecase) *ApplicationHandler {
+	return &ApplicationHandler{uc: uc}
+}
+
+func (h *ApplicationHandler) RegisterRoutes(r fiber.Router) {
+	if r == nil {
+		return
+	}
+
+	grp := r.Group("/me/applications")
+	grp.Get("/", h.List)
+	grp.Post("/", h.Create)
+	grp.Get("/summary", h.Summary)
+	grp.Get("/:id", h.Get)
+	grp.Patch("/:id", h.Update)
+	grp.Delete("/:id", h.Delete)
+	grp.Get("/:id/history", h.History)
+}
+
+func (h *ApplicationHandler) List(c fiber.Ctx) error {
+	userID, ok := c.Locals(middleware.CtxUserIDKey).(uuid.UUID)
+	if !ok {
+		return middleware.NewAppError(fiber.StatusUnauthorized, "Unauthorized", nil, nil)
+	}
+
+	items, err := h.uc.List(c.Context(), userID, c.Query("stage"))
+	if err != nil {
+		return mapApplicationUsecaseError(err)
+	}
+
+	res := make([]dto.ApplicationResponse, 0, len(items))
+	for _, it := range items {
+		res = append(res, toApplicationResponse(it))
+	}
+	return response.Success(c, fiber.StatusOK, response.MessageOK, res)
+}
+
+func (h *ApplicationHandler) Create(c fiber.Ctx) error {
+	userID, ok := c.Locals(middleware.CtxUserIDKey).(uuid.UUID)
+	if !ok {
+		return middleware.NewAppError(fiber.StatusUnauthorized, "Unauthorized", nil, nil)
+	}
+
+	var req createApplicationRequest
+	if err := c.Bind().Body(&req); err != nil {
+		return middleware.NewAppError(fiber.StatusBadRequest, "Bad request", nil, err)
+	}
+	interviewAt, err := parseOptionalTime(req.InterviewAt)
+	if err != nil {
+		return middleware.NewAppError(fiber.StatusBadRequest, "interview_at must be an RFC3339 timestamp", nil, err)
+	}
+
+	created, err := h.uc.Create(c.Context(), userID, usecase.CreateApplicationInput{
+		JobID:        req.JobID,
+		Stage:        req.Stage,
+		Notes:        req.Notes,
+		ContactName:  req.ContactName,
+		ContactEmail: req.ContactEmail,
+		ContactPhone: req.ContactPhone,
+		InterviewAt:  interviewAt,
+	})
+	if err != nil {
+		return mapApplicationUsecaseError(err)
+	}
+	return response.Success(c, fiber.StatusCreated, response.MessageOK, toApplicationResponse(created))
+}
+
+func (h *ApplicationHandler) Get(c fiber.Ctx) error {
+	userID, ok := c.Locals(middleware.CtxUserIDKey).(uuid.UUID)
+	if !ok {
+		return middleware.NewAppError(fiber.StatusUnauthorized, "Unauthorized", nil, nil)
+	}
+	id, err := uuid.Parse(c.Params("id"))
+	if err != nil {
+		return middleware.NewAppError(fiber.StatusBadRequest, "Bad request", nil, err)
+	}
+
+	it, err := h.uc.Get(c.Context(), userID, id)
+	if err != nil {
+		return mapApplicationUsecaseError(err)
+	}
+	return response.Success(c, fiber.StatusOK, response.MessageOK, toApplicationResponse(it))
+}
+
+func (h *ApplicationHandler) Update(c fiber.Ctx) error {
+	userID, ok := c.Locals(middleware.CtxUserIDKey).(uuid.UUID)
+	if !ok {
+		return middleware.NewAppError(fiber.StatusUnauthorized, "Unauthorized", nil, nil)
+	}
+	id, err := uuid.Parse(c.Params("id"))
+	if err != nil {
+		return middleware.NewAppError(fiber.StatusBadRequest, "Bad request", nil, err)
+	}
+
+	var req updateApplicationRequest
+	if err := c.Bind().Body(&req); err != nil {
+		return middleware.NewAppError(fiber.StatusBadRequest, "Bad request", nil, err)
+	}
+
+	in := usecase.UpdateApplicationInput{
+		Stage:        req.Stage,
+		StageNote:    req.StageNote,
+		Notes:        req.Notes,
+		ContactName:  req.ContactName,
+		ContactEmail: req.ContactEmail,
+		ContactPhone: req.ContactPhone,
+	}
+	if req.InterviewAt != nil {
+		t, err := parseOptionalTime(*req.InterviewAt)
+		if err != nil {
+			return middleware.NewAppError(fiber.StatusBadRequest, "interview_at must be an RFC3339 timestamp", nil, err)
+		}
+		in.InterviewAt = t
+		in.ClearInterviewAt = t == nil
+	}
+
+	updated, err := h.uc.Update(c.Context(), userID, id, in)
+	if err != nil {
+		return mapApplicationUsecaseError(err)
+	}
+	return response.Success(c, fiber.StatusOK, response.MessageOK, toApplicationResponse(updated))
+}
+
+func (h *ApplicationHandler) Delete(c fiber.Ctx) error {
+	userID, ok := c.Locals(middleware.CtxUserIDKey).(uuid.UUID)
+	if !ok {
+		return middleware.NewAppError(fiber.StatusUnauthorized, "Unauthorized", nil, nil)
+	}
+	id, err := uuid.Parse(c.Params("id"))
+	if err != nil {
+		return middleware.NewAppError(fiber.StatusBadRequest, "Bad request", nil, err)
+	}
+
+	if err := h.uc.Delete(c.Context(), userID, id); err != nil {
+		return mapApplicationUsecaseError(err)
+	}
+	return response.Success(c, fiber.StatusOK, response.MessageOK, nil)
+}
+
+func (h *ApplicationHandler) History(c fiber.Ctx) error {
+	userID, ok := c.Locals(middleware.CtxUserIDKey).(uuid.UUID)
+	if !ok {
+		return middleware.NewAppError(fiber.StatusUnauthorized, "Unauthorized", nil, nil)
+	}
+	id, err := uuid.Parse(c.Params("id"))
+	if err != nil {
+		return middleware.NewAppError(fiber.StatusBadRequest, "Bad request", nil, err)
+	}
+
+	events, err := h.uc.History(c.Context(), userID, id)
+	if err != nil {
+		return mapApplicationUsecaseError(err)
+	}
+
+	res := make([]dto.ApplicationStageEventResponse, 0, len(events))
+	for _, e := range events {
+		res = append(res, dto.ApplicationStageEventResponse{
+			FromStage: e.FromStage,
+			ToStage:   e.ToStage,
+			Note:      e.Note,
+			CreatedAt: e.CreatedAt,
+		})
+	}
+	return response.Success(c, fiber.StatusOK, response.MessageOK, res)
+}
+
+func (h *ApplicationHandler) Summary(c fiber.Ctx) error {
+	userID, ok := c.Locals(middleware.CtxUserIDKey).(uuid.UUID)
+	if !ok {
+		return middleware.NewAppError(fiber.StatusUnauthorized, "Unauthorized", nil, nil)
+	}
+
+	sum, err := h.uc.Summary(c.Context(), userID)
+	if err != nil {
+		return mapApplicationUsecaseError(err)
+	}
+
+	res := dto.ApplicationSummaryResponse{Total: sum.Total, ByStage: make(map[string]int, len(sum.ByStage))}
+	for _, s := range sum.ByStage {
+		res.ByStage[s.Stage] = s.Count
+	}
+	return response.Success(c, fiber.StatusOK, response.MessageOK, res)
+}
+
+func toApplicationResponse(it usecase.ApplicationItem) dto.ApplicationResponse {
+	return dto.ApplicationResponse{
+		ID:          it.ID,
+		JobID:       it.JobID,
+		Title:       it.Title,
+		CompanyName: it.Company,
+		Location:    it.Location,
+		Stage:       it.Stage,
+		Notes:       it.Notes,
+		Contact: dto.ApplicationContact{
+			Name:  it.ContactName,
+			Email: it.ContactEmail,
+			Phone: it.ContactPhone,
+		},
+		InterviewAt:       it.InterviewAt,
+		SavedMatchScore:   it.SavedMatchScore,
+		CurrentMatchScore: it.CurrentMatchScore,
+		ScoreChange:       it.ScoreChange,
+		AppliedAt:         it.AppliedAt,
+		StageChangedAt:    it.StageChangedAt,
+		CreatedAt:         it.CreatedAt,
+		UpdatedAt:         it.UpdatedAt,
+	}
+}
+
+func parseOptionalTime(s string) (*time.Time, error) {
+	s = strings.TrimSpace(s)
+	if s == "" {
+		return nil, nil
+	}
+	t, err := time.Parse(time.RFC3339, s)
+	if err != nil {
+		return nil, err
+	}
+	return &t, nil
+}
+
+func mapApplicationUsecaseError(err error) error {
+	if err == nil {
+		return nil
+	}
+
+	switch {
+	case errors.Is(err, usecase.ErrUnauthorized):
+		return middleware.NewAppError(fiber.StatusUnauthorized, "Unauthorized", nil, err)
+	case errors.Is(err, usecase.ErrInvalidInput):
+		return middleware.NewAppError(fiber.StatusBadRequest, "Bad request", nil, err)
+	case errors.Is(err, usecase.ErrJobNotFound):
+		return middleware.NewAppError(fiber.StatusNotFound, "Job not found", nil, err)
+	case errors.Is(err, usecase.ErrApplicationNotFound):
+		return middleware.NewAppError(fiber.StatusNotFound, "Application not found", nil, err)
+	case errors.Is(err, usecase.ErrApplicationExists):
+		return middleware.NewAppError(fiber.StatusConflict, "Application already exists", nil, err)
+	default:
+		return middleware.NewAppError(fiber.StatusInternalServerError, response.MessageInternalServerError, nil, err)
+	}
+}
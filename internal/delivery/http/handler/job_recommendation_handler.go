@@ -38,6 +38,8 @@ func (h *JobRecommendationHandler) GetRecommendations(c fiber.Ctx) error {
 	limit := parseQueryInt(c, "limit", 20)
 	offset := parseQueryInt(c, "offset", 0)
 	minScore := parseQueryInt(c, "min_score", 0)
+	diversity := parseQueryFloat(c, "diversity", 0)
+	maxPerCompany := parseQueryInt(c, "max_per_company", 0)
 	if limit > 50 {
 		limit = 50
 	}
@@ -50,11 +52,19 @@ func (h *JobRecommendationHandler) GetRecommendations(c fiber.Ctx) error {
 	if minScore < 0 {
 		minScore = 0
 	}
+	if diversity < 0 || diversity > 1 {
+		return middleware.NewAppError(fiber.StatusBadRequest, "diversity must be between 0 and 1", nil, nil)
+	}
+	if maxPerCompany < 0 {
+		maxPerCompany = 0
+	}
 
 	items, err := h.uc.GetRecommendations(c.Context(), userID, usecase.JobRecommendationParams{
-		Limit:    limit,
-		Offset:   offset,
-		MinScore: minScore,
+		Limit:         limit,
+		Offset:        offset,
+		MinScore:      minScore,
+		Diversity:     diversity,
+		MaxPerCompany: maxPerCompany,
 	})
 	if err != nil {
 		return mapJobRecommendationUsecaseError(err)
@@ -78,6 +88,9 @@ func (h *JobRecommendationHandler) GetRecommendations(c fiber.Ctx) error {
 			MatchScore:       it.MatchScore,
 			MandatoryMissing: it.MandatoryMissing,
 			MissingSkills:    missing,
+			Rank:             it.Rank,
+			OriginalRank:     it.OriginalRank,
+			RerankReason:     it.RerankReason,
 		})
 	}
 
@@ -96,6 +109,18 @@ func parseQueryInt(c fiber.Ctx, key string, defaultVal int) int {
 	return v
 }
 
+func parseQueryFloat(c fiber.Ctx, key string, defaultVal float64) float64 {
+	s := c.Query(key)
+	if s == "" {
+		return defaultVal
+	}
+	v, err := strconv.ParseFloat(s, 64)
+	if err != nil {
+		return defaultVal
+	}
+	return v
+}
+
 func mapJobRecommendationUsecaseError(err error) error {
 	if err == nil {
 		return nil
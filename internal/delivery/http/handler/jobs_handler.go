@@ -4,6 +4,7 @@ import (
 	"encoding/json"
 	"errors"
 	"html"
+	"math"
 	"strconv"
 	"strings"
 	"time"
@@ -11,17 +12,20 @@ import (
 	"skill-sync/internal/delivery/http/dto"
 	"skill-sync/internal/delivery/http/middleware"
 	"skill-sync/internal/pkg/response"
+	"skill-sync/internal/search"
 	"skill-sync/internal/usecase"
 
 	"github.com/gofiber/fiber/v3"
+	"github.com/google/uuid"
 )
 
 type JobsHandler struct {
-	uc usecase.JobListUsecase
+	uc      usecase.JobListUsecase
+	suggest usecase.SearchSuggestUsecase
 }
 
-func NewJobsHandler(uc usecase.JobListUsecase) *JobsHandler {
-	return &JobsHandler{uc: uc}
+func NewJobsHandler(uc usecase.JobListUsecase, suggest usecase.SearchSuggestUsecase) *JobsHandler {
+	return &JobsHandler{uc: uc, suggest: suggest}
 }
 
 func (h *JobsHandler) HandleListJobs(c fiber.Ctx) error {
@@ -29,6 +33,7 @@ func (h *JobsHandler) HandleListJobs(c fiber.Ctx) error {
 	companyName := c.Query("company_name")
 	location := c.Query("location")
 	skills := parseSkillsQuery(c.Query("skills"))
+	seniority := parseSkillsQuery(c.Query("seniority"))
 
 	limit, err := parseQueryIntStrict(c, "limit", 20)
 	if err != nil {
@@ -39,20 +44,57 @@ func (h *JobsHandler) HandleListJobs(c fiber.Ctx) error {
 		return middleware.NewAppError(fiber.StatusBadRequest, "Bad request", nil, err)
 	}
 
-	items, partial, err := h.uc.ListJobs(c.Context(), usecase.JobListParams{
+	radiusKm, err := parseQueryFloatStrict(c, "radius_km", 0)
+	if err != nil {
+		return middleware.NewAppError(fiber.StatusBadRequest, "Bad request", nil, err)
+	}
+
+	salaryMin, err := parseQueryIntStrict(c, "salary_min", 0)
+	if err != nil {
+		return middleware.NewAppError(fiber.StatusBadRequest, "Bad request", nil, err)
+	}
+	salaryMax, err := parseQueryIntStrict(c, "salary_max", 0)
+	if err != nil {
+		return middleware.NewAppError(fiber.StatusBadRequest, "Bad request", nil, err)
+	}
+
+	explain := c.Query("explain") == "true"
+
+	// /jobs may be public; exclusions only apply when a user is signed in.
+	userID, _ := c.Locals(middleware.CtxUserIDKey).(uuid.UUID)
+
+	result, err := h.uc.ListJobs(c.Context(), usecase.JobListParams{
 		Title:       title,
 		CompanyName: companyName,
 		Location:    location,
 		Skills:      skills,
+		Seniority:   seniority,
 		Limit:       limit,
 		Offset:      offset,
+		Cursor:      c.Query("cursor"),
+		Total:       c.Query("total"),
+		UserID:      userID,
+		Autocorrect: c.Query("autocorrect") == "true",
+
+		Locations:       parseMultiQuery(c, "locations"),
+		Companies:       parseMultiQuery(c, "companies"),
+		Sources:         parseMultiQuery(c, "sources"),
+		EmploymentTypes: parseMultiQuery(c, "employment_types"),
+		Posted:          parseMultiQuery(c, "posted"),
+		Facets:          c.Query("facets") == "true",
+		Personalize:     c.Query("personalize") == "true",
+		Near:            c.Query("near"),
+		RadiusKm:        radiusKm,
+		SalaryMin:       salaryMin,
+		SalaryMax:       salaryMax,
+		Sort:            c.Query("sort"),
 	})
 	if err != nil {
 		return mapJobListUsecaseError(err)
 	}
 
-	out := make([]dto.JobListResponse, 0, len(items))
-	for _, it := range items {
+	out := make([]dto.JobListResponse, 0, len(result.Items))
+	for _, it := range result.Items {
 		posted := ""
 		if it.PostedAt != nil {
 			t := time.Time(*it.PostedAt)
@@ -79,7 +121,7 @@ func (h *JobsHandler) HandleListJobs(c fiber.Ctx) error {
 		}
 		descClean := sanitizeJobDescription(descSrc)
 
-		out = append(out, dto.JobListResponse{
+		item := dto.JobListResponse{
 			JobID:       it.JobID,
 			Title:       titleClean,
 			CompanyName: strings.TrimSpace(companyClean),
@@ -87,15 +129,112 @@ func (h *JobsHandler) HandleListJobs(c fiber.Ctx) error {
 			SourceURL:   strings.TrimSpace(it.SourceURL),
 			Description: descClean,
 			Skills:      it.Skills,
+			Seniority:   it.Seniority,
 			PostedDate:  posted,
-		})
+
+			MatchScore:       it.MatchScore,
+			MandatoryMissing: it.MandatoryMissing,
+		}
+		if it.DistanceKm != nil {
+			d := math.Round(*it.DistanceKm*10) / 10
+			item.DistanceKm = &d
+		}
+		if it.Salary != nil {
+			item.Salary = &dto.JobSalaryResponse{
+				MinMonthlyIDR: it.Salary.Min,
+				MaxMonthlyIDR: it.Salary.Max,
+				Currency:      it.Salary.Currency,
+				Period:        it.Salary.Period,
+			}
+		}
+		if explain && it.Score != nil {
+			item.Score = &dto.JobScoreResponse{
+				Relevance:     roundScore(it.Score.Relevance),
+				TextRank:      roundScore(it.Score.TextRank),
+				Freshness:     roundScore(it.Score.Freshness),
+				SourceQuality: roundScore(it.Score.SourceQuality),
+				DataQuality:   roundScore(it.Score.DataQuality),
+				Match:         roundScore(it.Score.Match),
+				Proximity:     roundScore(it.Score.Proximity),
+				FinalScore:    roundScore(it.Score.FinalScore),
+			}
+		}
+		out = append(out, item)
+	}
+
+	// First pages that found jobs count towards autocomplete popularity; cursor
+	// pages are follow-ups even though their offset is zero.
+	if h.suggest != nil && offset == 0 && c.Query("cursor") == "" && len(result.Items) > 0 {
+		q := title
+		if result.CorrectedQuery != "" {
+			q = result.CorrectedQuery
+		}
+		if strings.TrimSpace(q) != "" {
+			h.suggest.RecordSearch(q)
+		}
 	}
 
 	msg := "ok"
-	if partial {
+	if result.Partial {
 		msg = "partial data returned"
 	}
-	return response.Success(c, fiber.StatusOK, msg, out)
+	meta := dto.JobListMeta{
+		DidYouMean:        result.DidYouMean,
+		CorrectionApplied: result.CorrectedQuery != "",
+		CorrectedQuery:    result.CorrectedQuery,
+		FuzzyMatch:        result.FuzzyMatch,
+		DetectedLocation:  result.DetectedLocation,
+		NextCursor:        result.NextCursor,
+		PrevCursor:        result.PrevCursor,
+		Total:             result.Total,
+		TotalEstimated:    result.TotalEstimated,
+	}
+	if result.Facets != nil {
+		meta.Facets = make(map[string][]dto.JobFacetValue, len(result.Facets))
+		for name, values := range result.Facets {
+			items := make([]dto.JobFacetValue, 0, len(values))
+			for _, v := range values {
+				items = append(items, dto.JobFacetValue{Value: v.Value, Count: v.Count})
+			}
+			meta.Facets[name] = items
+		}
+	}
+	return response.SuccessWithMeta(c, fiber.StatusOK, msg, out, meta)
+}
+
+func (h *JobsHandler) HandleSuggest(c fiber.Ctx) error {
+	if h.suggest == nil {
+		return middleware.NewAppError(fiber.StatusNotFound, "Not found", nil, nil)
+	}
+	limit, err := parseQueryIntStrict(c, "limit", 0)
+	if err != nil {
+		return middleware.NewAppError(fiber.StatusBadRequest, "Bad request", nil, err)
+	}
+
+	res, err := h.suggest.Suggest(c.Context(), c.Query("q"), limit)
+	if err != nil {
+		return mapJobListUsecaseError(err)
+	}
+
+	group := func(typ string) []dto.JobSuggestion {
+		items := res.Groups[typ]
+		out := make([]dto.JobSuggestion, 0, len(items))
+		for _, it := range items {
+			text := it.Text
+			if typ == search.SuggestTitle {
+				text = sanitizeJobTitle(text)
+			}
+			out = append(out, dto.JobSuggestion{Text: text, Count: it.Count})
+		}
+		return out
+	}
+	return response.Success(c, fiber.StatusOK, response.MessageOK, dto.JobSuggestResponse{
+		Query:     res.Query,
+		Titles:    group(search.SuggestTitle),
+		Skills:    group(search.SuggestSkill),
+		Companies: group(search.SuggestCompany),
+		Locations: group(search.SuggestLocation),
+	})
 }
 
 func sanitizeJobTitle(s string) string {
@@ -287,6 +426,18 @@ func parseQueryIntStrict(c fiber.Ctx, key string, defaultVal int) (int, error) {
 	return v, nil
 }
 
+func parseQueryFloatStrict(c fiber.Ctx, key string, defaultVal float64) (float64, error) {
+	s := c.Query(key)
+	if s == "" {
+		return defaultVal, nil
+	}
+	v, err := strconv.ParseFloat(s, 64)
+	if err != nil || math.IsNaN(v) || math.IsInf(v, 0) {
+		return 0, errors.New("invalid " + key)
+	}
+	return v, nil
+}
+
 func parseSkillsQuery(s string) []string {
 	if s == "" {
 		return nil
@@ -303,6 +454,20 @@ func parseSkillsQuery(s string) []string {
 	return out
 }
 
+func roundScore(v float64) float64 {
+	return math.Round(v*100) / 100
+}
+
+// parseMultiQuery reads a multi-select filter given either as repeated
+// parameters (?sources=glints&sources=jobstreet) or comma-separated.
+func parseMultiQuery(c fiber.Ctx, key string) []string {
+	var out []string
+	for _, v := range c.RequestCtx().QueryArgs().PeekMulti(key) {
+		out = append(out, parseSkillsQuery(string(v))...)
+	}
+	return out
+}
+
 func mapJobListUsecaseError(err error) error {
 	if err == nil {
 		return nil
@@ -2,9 +2,11 @@ package handler
 
 import (
 	"errors"
+	"math"
 
 	"skill-sync/internal/delivery/http/dto"
 	"skill-sync/internal/delivery/http/middleware"
+	"skill-sync/internal/domain/matching"
 	"skill-sync/internal/pkg/response"
 	"skill-sync/internal/usecase"
 
@@ -16,6 +18,17 @@ type MatchV2Handler struct {
 	uc usecase.MatchingUsecaseV2
 }
 
+type simulateSkillChangeRequest struct {
+	Op      string    `json:"op"`
+	SkillID uuid.UUID `json:"skill_id"`
+	Level   int       `json:"level"`
+	Years   int       `json:"years"`
+}
+
+type simulateMatchRequest struct {
+	Changes []simulateSkillChangeRequest `json:"changes"`
+}
+
 func NewMatchV2Handler(uc usecase.MatchingUsecaseV2) *MatchV2Handler {
 	return &MatchV2Handler{uc: uc}
 }
@@ -26,6 +39,7 @@ func (h *MatchV2Handler) RegisterRoutes(r fiber.Router) {
 	}
 	grp := r.Group("/jobs")
 	grp.Get("/:job_id/match", h.GetMatchV2)
+	grp.Post("/:job_id/match/simulate", h.SimulateMatchV2)
 }
 
 func (h *MatchV2Handler) GetMatchV2(c fiber.Ctx) error {
@@ -44,11 +58,64 @@ func (h *MatchV2Handler) GetMatchV2(c fiber.Ctx) error {
 		return mapMatchingV2UsecaseError(err)
 	}
 
+	return response.Success(c, fiber.StatusOK, response.MessageOK, toMatchingResultResponseV2(res))
+}
+
+func (h *MatchV2Handler) SimulateMatchV2(c fiber.Ctx) error {
+	userID, ok := c.Locals(middleware.CtxUserIDKey).(uuid.UUID)
+	if !ok {
+		return middleware.NewAppError(fiber.StatusUnauthorized, "Unauthorized", nil, nil)
+	}
+
+	jobID, err := uuid.Parse(c.Params("job_id"))
+	if err != nil {
+		return middleware.NewAppError(fiber.StatusBadRequest, "Bad request", nil, err)
+	}
+
+	var req simulateMatchRequest
+	if err := c.Bind().Body(&req); err != nil {
+		return middleware.NewAppError(fiber.StatusBadRequest, "Bad request", nil, err)
+	}
+
+	changes := make([]usecase.SkillChange, 0, len(req.Changes))
+	for _, ch := range req.Changes {
+		changes = append(changes, usecase.SkillChange{Op: ch.Op, SkillID: ch.SkillID, Level: ch.Level, Years: ch.Years})
+	}
+
+	sim, err := h.uc.SimulateMatchV2(c.Context(), userID, jobID, changes)
+	if err != nil {
+		return mapMatchingV2UsecaseError(err)
+	}
+
+	out := dto.MatchSimulationResponseV2{
+		Current:   toMatchingResultResponseV2(sim.Current),
+		Simulated: toMatchingResultResponseV2(sim.Simulated),
+		Delta: dto.MatchDeltaResponseV2{
+			MatchScore: sim.Delta.MatchScore,
+			Mandatory:  sim.Delta.Mandatory,
+			Optional:   sim.Delta.Optional,
+			Experience: sim.Delta.Experience,
+			Seniority:  sim.Delta.Seniority,
+		},
+	}
+	return response.Success(c, fiber.StatusOK, response.MessageOK, out)
+}
+
+func toMatchingResultResponseV2(res matching.ResultV2) dto.MatchingResultResponseV2 {
 	out := dto.MatchingResultResponseV2{
 		MatchScore:       res.MatchScore,
 		MandatoryMissing: res.MandatoryMissing,
 		MatchedSkills:    make([]dto.MatchSkillResponseV2, 0, len(res.MatchedSkills)),
 		MissingSkills:    make([]dto.MissingSkillResponseV2, 0, len(res.MissingSkills)),
+		Breakdown: dto.ScoreBreakdownResponseV2{
+			Mandatory:  math.Round(res.Breakdown.Mandatory*100) / 100,
+			Optional:   math.Round(res.Breakdown.Optional*100) / 100,
+			Experience: math.Round(res.Breakdown.Experience*100) / 100,
+			Seniority:  math.Round(res.Breakdown.Seniority*100) / 100,
+		},
+		SeniorityGap:   res.SeniorityGap,
+		ScoringProfile: res.ProfileName,
+		ScoringVersion: res.ProfileVersion,
 	}
 	for _, ms := range res.MatchedSkills {
 		out.MatchedSkills = append(out.MatchedSkills, dto.MatchSkillResponseV2{
@@ -58,14 +125,27 @@ func (h *MatchV2Handler) GetMatchV2(c fiber.Ctx) error {
 		})
 	}
 	for _, ms := range res.MissingSkills {
-		out.MissingSkills = append(out.MissingSkills, dto.MissingSkillResponseV2{
-			SkillID:     ms.SkillID,
-			SkillName:   ms.SkillName,
-			IsMandatory: ms.IsMandatory,
-		})
+		out.MissingSkills = append(out.MissingSkills, toMissingSkillResponseV2(ms))
 	}
+	return out
+}
 
-	return response.Success(c, fiber.StatusOK, response.MessageOK, out)
+func toMissingSkillResponseV2(ms matching.MissingSkillV2) dto.MissingSkillResponseV2 {
+	out := dto.MissingSkillResponseV2{
+		SkillID:     ms.SkillID,
+		SkillName:   ms.SkillName,
+		IsMandatory: ms.IsMandatory,
+	}
+	if ms.CreditedBy != nil {
+		out.CreditedBy = &dto.SkillCreditResponseV2{
+			SkillID:           ms.CreditedBy.SkillID,
+			SkillName:         ms.CreditedBy.SkillName,
+			Relation:          ms.CreditedBy.Relation,
+			Credit:            ms.CreditedBy.Credit,
+			ScoreContribution: ms.CreditedBy.ScoreContribution,
+		}
+	}
+	return out
 }
 
 func mapMatchingV2UsecaseError(err error) error {
@@ -80,6 +160,12 @@ func mapMatchingV2UsecaseError(err error) error {
 		return middleware.NewAppError(fiber.StatusBadRequest, "User skill profile empty", nil, err)
 	case errors.Is(err, usecase.ErrUnauthorized):
 		return middleware.NewAppError(fiber.StatusUnauthorized, "Unauthorized", nil, err)
+	case errors.Is(err, usecase.ErrInvalidProficiencyLevel):
+		return middleware.NewAppError(fiber.StatusBadRequest, "Invalid proficiency level", nil, err)
+	case errors.Is(err, usecase.ErrSkillNotFound):
+		return middleware.NewAppError(fiber.StatusNotFound, "Skill not found", nil, err)
+	case errors.Is(err, usecase.ErrInvalidInput):
+		return middleware.NewAppError(fiber.StatusBadRequest, "Bad request", nil, err)
 	case errors.Is(err, usecase.ErrInternal):
 		return middleware.NewAppError(fiber.StatusInternalServerError, response.MessageInternalServerError, nil, err)
 	default:
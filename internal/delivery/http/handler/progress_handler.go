@@ -0,0 +1,128 @@
+package handler
+
+import (
+	"errors"
+
+	"skill-sync/internal/delivery/http/dto"
+	"skill-sync/internal/delivery/http/middleware"
+	"skill-sync/internal/pkg/response"
+	"skill-sync/internal/repository"
+	"skill-sync/internal/usecase"
+
+	"github.com/gofiber/fiber/v3"
+	"github.com/google/uuid"
+)
+
+type ProgressHandler struct {
+	uc usecase.ProgressUsecase
+}
+
+func NewProgressHandler(uc usecase.ProgressUsecase) *ProgressHandler {
+	return &ProgressHandler{uc: uc}
+}
+
+func (h *ProgressHandler) RegisterRoutes(r fiber.Router) {
+	if r == nil {
+		return
+	}
+	r.Get("/me/progress", h.GetProgress)
+	r.Get("/me/progress/jobs/:job_id", h.GetJobHistory)
+}
+
+func (h *ProgressHandler) GetProgress(c fiber.Ctx) error {
+	userID, ok := c.Locals(middleware.CtxUserIDKey).(uuid.UUID)
+	if !ok {
+		return middleware.NewAppError(fiber.StatusUnauthorized, "Unauthorized", nil, nil)
+	}
+
+	p, err := h.uc.GetProgress(c.Context(), userID, parseQueryInt(c, "days", 0))
+	if err != nil {
+		return mapProgressUsecaseError(err)
+	}
+
+	res := dto.ProgressResponse{
+		TopN:                p.TopN,
+		Days:                p.Days,
+		CurrentAverage:      p.Current,
+		Change:              p.Change,
+		Points:              make([]dto.ProgressPoint, 0, len(p.Points)),
+		PendingSkillChanges: toSkillChangeResponses(p.PendingSkillChanges),
+	}
+	for _, pt := range p.Points {
+		res.Points = append(res.Points, dto.ProgressPoint{
+			RecordedAt:     pt.RecordedAt,
+			TopAverage:     pt.TopAverage,
+			MatchedJobs:    pt.MatchedJobs,
+			Change:         pt.Change,
+			ScoringVersion: pt.ScoringVersion,
+			ScoringChanged: pt.ScoringChanged,
+			SkillChanges:   toSkillChangeResponses(pt.SkillChanges),
+		})
+	}
+	return response.Success(c, fiber.StatusOK, response.MessageOK, res)
+}
+
+func (h *ProgressHandler) GetJobHistory(c fiber.Ctx) error {
+	userID, ok := c.Locals(middleware.CtxUserIDKey).(uuid.UUID)
+	if !ok {
+		return middleware.NewAppError(fiber.StatusUnauthorized, "Unauthorized", nil, nil)
+	}
+	jobID, err := uuid.Parse(c.Params("job_id"))
+	if err != nil {
+		return middleware.NewAppError(fiber.StatusBadRequest, "Invalid job_id", nil, err)
+	}
+
+	items, err := h.uc.GetJobMatchHistory(c.Context(), userID, jobID)
+	if err != nil {
+		return mapProgressUsecaseError(err)
+	}
+
+	res := make([]dto.MatchHistoryResponse, 0, len(items))
+	for _, it := range items {
+		res = append(res, dto.MatchHistoryResponse{
+			MatchScore: it.Score,
+			Breakdown: dto.ScoreBreakdownResponseV2{
+				Mandatory:  it.Components.Mandatory,
+				Optional:   it.Components.Optional,
+				Experience: it.Components.Experience,
+				Seniority:  it.Components.Seniority,
+			},
+			ScoringProfile: it.ScoringProfile,
+			ScoringVersion: it.ScoringVersion,
+			RecordedAt:     it.RecordedAt,
+		})
+	}
+	return response.Success(c, fiber.StatusOK, response.MessageOK, res)
+}
+
+func toSkillChangeResponses(events []repository.SkillEvent) []dto.SkillChangeResponse {
+	out := make([]dto.SkillChangeResponse, 0, len(events))
+	for _, e := range events {
+		out = append(out, dto.SkillChangeResponse{
+			SkillID:        e.SkillID,
+			SkillName:      e.SkillName,
+			Action:         e.Action,
+			OldProficiency: e.OldProficiency,
+			NewProficiency: e.NewProficiency,
+			OldYears:       e.OldYears,
+			NewYears:       e.NewYears,
+			ChangedAt:      e.CreatedAt,
+		})
+	}
+	return out
+}
+
+func mapProgressUsecaseError(err error) error {
+	if err == nil {
+		return nil
+	}
+
+	switch {
+	case errors.Is(err, usecase.ErrUnauthorized):
+		return middleware.NewAppError(fiber.StatusUnauthorized, "Unauthorized", nil, err)
+	case errors.Is(err, usecase.ErrInvalidInput):
+		return middleware.NewAppError(fiber.StatusBadRequest, "Bad request", nil, err)
+	default:
+		return middleware.NewAppError(fiber.StatusInternalServerError, response.MessageInternalServerError, nil, err)
+	}
+}
@@ -0,0 +1,234 @@
+package handler
+
+import (
+	"errors"
+
+	"skill-sync/internal/delivery/http/dto"
+	"skill-sync/internal/delivery/http/middleware"
+	"skill-sync/internal/pkg/response"
+	"skill-sync/internal/repository"
+	"skill-sync/internal/usecase"
+
+	"github.com/gofiber/fiber/v3"
+	"github.com/google/uuid"
+)
+
+type SavedSearchHandler struct {
+	uc usecase.SavedSearchUsecase
+}
+
+type savedSearchRequest struct {
+	Name        string   `json:"name"`
+	Title       string   `json:"title"`
+	CompanyName string   `json:"company_name"`
+	Location    string   `json:"location"`
+	Skills      []string `json:"skills"`
+	Seniority   []string `json:"seniority"`
+	Notify      string   `json:"notify"`
+}
+
+func NewSavedSearchHandler(uc usecase.SavedSearchUsecase) *SavedSearchHandler {
+	return &SavedSearchHandler{uc: uc}
+}
+
+func (h *SavedSearchHandler) RegisterRoutes(r fiber.Router) {
+	if r == nil {
+		return
+	}
+
+	grp := r.Group("/me/saved-searches")
+	grp.Get("/", h.List)
+	grp.Post("/", h.Create)
+	grp.Get("/:id", h.Get)
+	grp.Put("/:id", h.Update)
+	grp.Delete("/:id", h.Delete)
+	grp.Get("/:id/matches", h.ListMatches)
+	grp.Post("/:id/matches/seen", h.MarkSeen)
+}
+
+func (h *SavedSearchHandler) List(c fiber.Ctx) error {
+	userID, ok := c.Locals(middleware.CtxUserIDKey).(uuid.UUID)
+	if !ok {
+		return middleware.NewAppError(fiber.StatusUnauthorized, "Unauthorized", nil, nil)
+	}
+
+	items, err := h.uc.List(c.Context(), userID)
+	if err != nil {
+		return mapSavedSearchUsecaseError(err)
+	}
+
+	res := make([]dto.SavedSearchResponse, 0, len(items))
+	for _, it := range items {
+		res = append(res, toSavedSearchResponse(it))
+	}
+	return response.Success(c, fiber.StatusOK, response.MessageOK, res)
+}
+
+func (h *SavedSearchHandler) Create(c fiber.Ctx) error {
+	userID, ok := c.Locals(middleware.CtxUserIDKey).(uuid.UUID)
+	if !ok {
+		return middleware.NewAppError(fiber.StatusUnauthorized, "Unauthorized", nil, nil)
+	}
+
+	var req savedSearchRequest
+	if err := c.Bind().Body(&req); err != nil {
+		return middleware.NewAppError(fiber.StatusBadRequest, "Bad request", nil, err)
+	}
+
+	created, err := h.uc.Create(c.Context(), userID, req.toInput())
+	if err != nil {
+		return mapSavedSearchUsecaseError(err)
+	}
+	return response.Success(c, fiber.StatusCreated, response.MessageOK, toSavedSearchResponse(created))
+}
+
+func (h *SavedSearchHandler) Get(c fiber.Ctx) error {
+	userID, ok := c.Locals(middleware.CtxUserIDKey).(uuid.UUID)
+	if !ok {
+		return middleware.NewAppError(fiber.StatusUnauthorized, "Unauthorized", nil, nil)
+	}
+	id, err := uuid.Parse(c.Params("id"))
+	if err != nil {
+		return middleware.NewAppError(fiber.StatusBadRequest, "Bad request", nil, err)
+	}
+
+	it, err := h.uc.Get(c.Context(), userID, id)
+	if err != nil {
+		return mapSavedSearchUsecaseError(err)
+	}
+	return response.Success(c, fiber.StatusOK, response.MessageOK, toSavedSearchResponse(it))
+}
+
+func (h *SavedSearchHandler) Update(c fiber.Ctx) error {
+	userID, ok := c.Locals(middleware.CtxUserIDKey).(uuid.UUID)
+	if !ok {
+		return middleware.NewAppError(fiber.StatusUnauthorized, "Unauthorized", nil, nil)
+	}
+	id, err := uuid.Parse(c.Params("id"))
+	if err != nil {
+		return middleware.NewAppError(fiber.StatusBadRequest, "Bad request", nil, err)
+	}
+
+	var req savedSearchRequest
+	if err := c.Bind().Body(&req); err != nil {
+		return middleware.NewAppError(fiber.StatusBadRequest, "Bad request", nil, err)
+	}
+
+	updated, err := h.uc.Update(c.Context(), userID, id, req.toInput())
+	if err != nil {
+		return mapSavedSearchUsecaseError(err)
+	}
+	return response.Success(c, fiber.StatusOK, response.MessageOK, toSavedSearchResponse(updated))
+}
+
+func (h *SavedSearchHandler) Delete(c fiber.Ctx) error {
+	userID, ok := c.Locals(middleware.CtxUserIDKey).(uuid.UUID)
+	if !ok {
+		return middleware.NewAppError(fiber.StatusUnauthorized, "Unauthorized", nil, nil)
+	}
+	id, err := uuid.Parse(c.Params("id"))
+	if err != nil {
+		return middleware.NewAppError(fiber.StatusBadRequest, "Bad request", nil, err)
+	}
+
+	if err := h.uc.Delete(c.Context(), userID, id); err != nil {
+		return mapSavedSearchUsecaseError(err)
+	}
+	return response.Success(c, fiber.StatusOK, response.MessageOK, nil)
+}
+
+func (h *SavedSearchHandler) ListMatches(c fiber.Ctx) error {
+	userID, ok := c.Locals(middleware.CtxUserIDKey).(uuid.UUID)
+	if !ok {
+		return middleware.NewAppError(fiber.StatusUnauthorized, "Unauthorized", nil, nil)
+	}
+	id, err := uuid.Parse(c.Params("id"))
+	if err != nil {
+		return middleware.NewAppError(fiber.StatusBadRequest, "Bad request", nil, err)
+	}
+
+	unseenOnly := c.Query("unseen") == "true"
+	items, err := h.uc.ListMatches(c.Context(), userID, id, unseenOnly, parseQueryInt(c, "limit", 20), parseQueryInt(c, "offset", 0))
+	if err != nil {
+		return mapSavedSearchUsecaseError(err)
+	}
+
+	res := make([]dto.SavedSearchMatchResponse, 0, len(items))
+	for _, it := range items {
+		res = append(res, dto.SavedSearchMatchResponse{
+			JobID:       it.JobID,
+			Title:       it.Title,
+			CompanyName: it.CompanyName,
+			Location:    it.Location,
+			MatchedAt:   it.MatchedAt,
+			SeenAt:      it.SeenAt,
+		})
+	}
+	return response.Success(c, fiber.StatusOK, response.MessageOK, res)
+}
+
+func (h *SavedSearchHandler) MarkSeen(c fiber.Ctx) error {
+	userID, ok := c.Locals(middleware.CtxUserIDKey).(uuid.UUID)
+	if !ok {
+		return middleware.NewAppError(fiber.StatusUnauthorized, "Unauthorized", nil, nil)
+	}
+	id, err := uuid.Parse(c.Params("id"))
+	if err != nil {
+		return middleware.NewAppError(fiber.StatusBadRequest, "Bad request", nil, err)
+	}
+
+	if err := h.uc.MarkMatchesSeen(c.Context(), userID, id); err != nil {
+		return mapSavedSearchUsecaseError(err)
+	}
+	return response.Success(c, fiber.StatusOK, response.MessageOK, nil)
+}
+
+func (r savedSearchRequest) toInput() usecase.SavedSearchInput {
+	return usecase.SavedSearchInput{
+		Name: r.Name,
+		Query: usecase.JobListParams{
+			Title:       r.Title,
+			CompanyName: r.CompanyName,
+			Location:    r.Location,
+			Skills:      r.Skills,
+			Seniority:   r.Seniority,
+		},
+		Notify: r.Notify,
+	}
+}
+
+func toSavedSearchResponse(it repository.SavedSearch) dto.SavedSearchResponse {
+	return dto.SavedSearchResponse{
+		ID:   it.ID,
+		Name: it.Name,
+		Query: dto.SavedSearchQuery{
+			Title:       it.Title,
+			CompanyName: it.CompanyName,
+			Location:    it.Location,
+			Skills:      it.Skills,
+			Seniority:   it.Seniority,
+		},
+		Notify:        it.Notify,
+		UnseenMatches: it.UnseenMatches,
+		LastMatchedAt: it.LastMatchedAt,
+		CreatedAt:     it.CreatedAt,
+		UpdatedAt:     it.UpdatedAt,
+	}
+}
+
+func mapSavedSearchUsecaseError(err error) error {
+	if err == nil {
+		return nil
+	}
+
+	switch {
+	case errors.Is(err, usecase.ErrUnauthorized):
+		return middleware.NewAppError(fiber.StatusUnauthorized, "Unauthorized", nil, err)
+	case errors.Is(err, usecase.ErrInvalidInput):
+		return middleware.NewAppError(fiber.StatusBadRequest, "Bad request", nil, err)
+	case errors.Is(err, usecase.ErrSavedSearchNotFound):
+		return middleware.NewAppError(fiber.StatusNotFound, "Saved search not found", nil, err)
+	default:
+		return middleware.NewAppError(fiber.StatusInternalServerError, response.MessageInternalServerError, nil, err)
+	}
+}
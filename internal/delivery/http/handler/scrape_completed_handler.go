@@ -24,14 +24,19 @@ type scrapeCacheInvalidator interface {
 	InvalidateCacheByKeyword(ctx context.Context, keyword string) error
 }
 
+type newJobsProcessor interface {
+	ProcessNewJobs(ctx context.Context) (int, error)
+}
+
 type ScrapeCompletedHandler struct {
 	cfg    config.Config
 	cache  scrapeCacheInvalidator
+	alerts newJobsProcessor
 	logger *log.Logger
 }
 
-func NewScrapeCompletedHandler(cfg config.Config, cache scrapeCacheInvalidator, logger *log.Logger) *ScrapeCompletedHandler {
-	return &ScrapeCompletedHandler{cfg: cfg, cache: cache, logger: logger}
+func NewScrapeCompletedHandler(cfg config.Config, cache scrapeCacheInvalidator, alerts newJobsProcessor, logger *log.Logger) *ScrapeCompletedHandler {
+	return &ScrapeCompletedHandler{cfg: cfg, cache: cache, alerts: alerts, logger: logger}
 }
 
 func (h *ScrapeCompletedHandler) HandleScrapeCompleted(c fiber.Ctx) error {
@@ -84,6 +89,21 @@ func (h *ScrapeCompletedHandler) HandleScrapeCompleted(c fiber.Ctx) error {
 		h.logger.Printf("WS notify | type=jobs_updated keyword=%s source=%s", req.Keyword, req.Source)
 	}
 
+	if h.alerts != nil {
+		go func() {
+			ctx, cancel := context.WithTimeout(context.Background(), 2*time.Minute)
+			defer cancel()
+			n, err := h.alerts.ProcessNewJobs(ctx)
+			if h.logger != nil {
+				if err != nil {
+					h.logger.Printf("Saved search alerts error | error=%v", err)
+				} else {
+					h.logger.Printf("Saved search alerts | keyword=%s new_matches=%d", req.Keyword, n)
+				}
+			}
+		}()
+	}
+
 	return c.Status(fiber.StatusOK).JSON(fiber.Map{
 		"status":  "cache_invalidated",
 		"keyword": req.Keyword,
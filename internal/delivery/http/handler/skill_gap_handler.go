@@ -0,0 +1,93 @@
+package handler
+
+import (
+	"errors"
+
+	"skill-sync/internal/delivery/http/dto"
+	"skill-sync/internal/delivery/http/middleware"
+	"skill-sync/internal/pkg/response"
+	"skill-sync/internal/usecase"
+
+	"github.com/gofiber/fiber/v3"
+	"github.com/google/uuid"
+)
+
+type SkillGapHandler struct {
+	uc usecase.SkillGapUsecase
+}
+
+func NewSkillGapHandler(uc usecase.SkillGapUsecase) *SkillGapHandler {
+	return &SkillGapHandler{uc: uc}
+}
+
+func (h *SkillGapHandler) RegisterRoutes(r fiber.Router) {
+	if r == nil {
+		return
+	}
+	r.Get("/me/skill-gaps", h.GetSkillGaps)
+}
+
+func (h *SkillGapHandler) GetSkillGaps(c fiber.Ctx) error {
+	userID, ok := c.Locals(middleware.CtxUserIDKey).(uuid.UUID)
+	if !ok {
+		return middleware.NewAppError(fiber.StatusUnauthorized, "Unauthorized", nil, nil)
+	}
+
+	plan, err := h.uc.GetSkillGaps(c.Context(), userID, usecase.SkillGapParams{
+		Jobs:      parseQueryInt(c, "jobs", 0),
+		Threshold: parseQueryInt(c, "threshold", 0),
+		Limit:     parseQueryInt(c, "limit", 0),
+	})
+	if err != nil {
+		return mapSkillGapUsecaseError(err)
+	}
+
+	out := dto.SkillGapPlanResponse{
+		JobsConsidered: plan.JobsConsidered,
+		Threshold:      plan.Threshold,
+		Skills:         make([]dto.SkillGapItemResponse, 0, len(plan.Skills)),
+	}
+	for i, it := range plan.Skills {
+		jobs := make([]dto.SkillGapJobResponse, 0, len(it.Jobs))
+		for _, j := range it.Jobs {
+			jobs = append(jobs, dto.SkillGapJobResponse{
+				JobID:            j.JobID,
+				Title:            j.Title,
+				CompanyName:      j.CompanyName,
+				CurrentScore:     j.CurrentScore,
+				SimulatedScore:   j.SimulatedScore,
+				MandatoryCleared: j.MandatoryCleared,
+				CrossedThreshold: j.CrossedThreshold,
+			})
+		}
+		out.Skills = append(out.Skills, dto.SkillGapItemResponse{
+			Rank:         i + 1,
+			SkillID:      it.SkillID,
+			SkillName:    it.SkillName,
+			TotalPoints:  it.TotalPoints,
+			JobsAffected: it.JobsAffected,
+			JobsUnlocked: it.JobsUnlocked,
+			MandatoryIn:  it.MandatoryIn,
+			Jobs:         jobs,
+		})
+	}
+
+	return response.Success(c, fiber.StatusOK, response.MessageOK, out)
+}
+
+func mapSkillGapUsecaseError(err error) error {
+	if err == nil {
+		return nil
+	}
+
+	switch {
+	case errors.Is(err, usecase.ErrUnauthorized):
+		return middleware.NewAppError(fiber.StatusUnauthorized, "Unauthorized", nil, err)
+	case errors.Is(err, usecase.ErrUserSkillProfileEmpty):
+		return middleware.NewAppError(fiber.StatusBadRequest, "User skill profile empty", nil, err)
+	case errors.Is(err, usecase.ErrNoJobsFound):
+		return middleware.NewAppError(fiber.StatusNotFound, "No jobs found", nil, err)
+	default:
+		return middleware.NewAppError(fiber.StatusInternalServerError, response.MessageInternalServerError, nil, err)
+	}
+}
@@ -0,0 +1,212 @@
+package handler
+
+import (
+	"errors"
+	"strings"
+
+	"skill-sync/internal/delivery/http/dto"
+	"skill-sync/internal/delivery/http/middleware"
+	"skill-sync/internal/pkg/response"
+	"skill-sync/internal/usecase"
+
+	"github.com/gofiber/fiber/v3"
+	"github.com/google/uuid"
+)
+
+type UserFeedbackHandler struct {
+	uc usecase.UserFeedbackUsecase
+}
+
+type dismissJobRequest struct {
+	Reason string `json:"reason"`
+	Note   string `json:"note"`
+}
+
+type blockCompanyRequest struct {
+	CompanyName string `json:"company_name"`
+}
+
+func NewUserFeedbackHandler(uc usecase.UserFeedbackUsecase) *UserFeedbackHandler {
+	return &UserFeedbackHandler{uc: uc}
+}
+
+// RegisterRoutes mounts the per-user lists under the /users group.
+func (h *UserFeedbackHandler) RegisterRoutes(r fiber.Router) {
+	if r == nil {
+		return
+	}
+	r.Get("/me/dismissed-jobs", h.ListDismissed)
+
+	grp := r.Group("/me/blocked-companies")
+	grp.Get("/", h.ListBlocked)
+	grp.Post("/", h.Block)
+	grp.Delete("/:id", h.Unblock)
+}
+
+func (h *UserFeedbackHandler) RegisterJobRoutes(r fiber.Router) {
+	if r == nil {
+		return
+	}
+	r.Post("/jobs/:job_id/dismiss", h.Dismiss)
+	r.Delete("/jobs/:job_id/dismiss", h.Undismiss)
+}
+
+func (h *UserFeedbackHandler) RegisterAdminRoutes(r fiber.Router) {
+	if r == nil {
+		return
+	}
+	r.Get("/feedback/dismissal-reasons", h.ReasonStats)
+}
+
+func (h *UserFeedbackHandler) Dismiss(c fiber.Ctx) error {
+	userID, ok := c.Locals(middleware.CtxUserIDKey).(uuid.UUID)
+	if !ok {
+		return middleware.NewAppError(fiber.StatusUnauthorized, "Unauthorized", nil, nil)
+	}
+	jobID, err := uuid.Parse(strings.TrimSpace(c.Params("job_id")))
+	if err != nil {
+		return middleware.NewAppError(fiber.StatusBadRequest, "Invalid job_id", nil, err)
+	}
+
+	var req dismissJobRequest
+	if len(c.Body()) > 0 {
+		if err := c.Bind().Body(&req); err != nil {
+			return middleware.NewAppError(fiber.StatusBadRequest, "Bad request", nil, err)
+		}
+	}
+
+	if err := h.uc.DismissJob(c.Context(), userID, jobID, usecase.DismissJobInput{Reason: req.Reason, Note: req.Note}); err != nil {
+		return mapUserFeedbackUsecaseError(err)
+	}
+	return response.Success(c, fiber.StatusOK, "Job dismissed successfully", nil)
+}
+
+func (h *UserFeedbackHandler) Undismiss(c fiber.Ctx) error {
+	userID, ok := c.Locals(middleware.CtxUserIDKey).(uuid.UUID)
+	if !ok {
+		return middleware.NewAppError(fiber.StatusUnauthorized, "Unauthorized", nil, nil)
+	}
+	jobID, err := uuid.Parse(strings.TrimSpace(c.Params("job_id")))
+	if err != nil {
+		return middleware.NewAppError(fiber.StatusBadRequest, "Invalid job_id", nil, err)
+	}
+
+	if err := h.uc.UndismissJob(c.Context(), userID, jobID); err != nil {
+		return mapUserFeedbackUsecaseError(err)
+	}
+	return response.Success(c, fiber.StatusOK, "Job restored successfully", nil)
+}
+
+func (h *UserFeedbackHandler) ListDismissed(c fiber.Ctx) error {
+	userID, ok := c.Locals(middleware.CtxUserIDKey).(uuid.UUID)
+	if !ok {
+		return middleware.NewAppError(fiber.StatusUnauthorized, "Unauthorized", nil, nil)
+	}
+
+	items, err := h.uc.ListDismissedJobs(c.Context(), userID)
+	if err != nil {
+		return mapUserFeedbackUsecaseError(err)
+	}
+
+	res := make([]dto.DismissedJobResponse, 0, len(items))
+	for _, it := range items {
+		res = append(res, dto.DismissedJobResponse{
+			JobID:       it.JobID,
+			Title:       it.Title,
+			CompanyName: it.Company,
+			Location:    it.Location,
+			Reason:      it.Reason,
+			Note:        it.Note,
+			DismissedAt: it.CreatedAt,
+		})
+	}
+	return response.Success(c, fiber.StatusOK, response.MessageOK, res)
+}
+
+func (h *UserFeedbackHandler) ListBlocked(c fiber.Ctx) error {
+	userID, ok := c.Locals(middleware.CtxUserIDKey).(uuid.UUID)
+	if !ok {
+		return middleware.NewAppError(fiber.StatusUnauthorized, "Unauthorized", nil, nil)
+	}
+
+	items, err := h.uc.ListBlockedCompanies(c.Context(), userID)
+	if err != nil {
+		return mapUserFeedbackUsecaseError(err)
+	}
+
+	res := make([]dto.BlockedCompanyResponse, 0, len(items))
+	for _, it := range items {
+		res = append(res, dto.BlockedCompanyResponse{ID: it.ID, CompanyName: it.CompanyName, BlockedAt: it.CreatedAt})
+	}
+	return response.Success(c, fiber.StatusOK, response.MessageOK, res)
+}
+
+func (h *UserFeedbackHandler) Block(c fiber.Ctx) error {
+	userID, ok := c.Locals(middleware.CtxUserIDKey).(uuid.UUID)
+	if !ok {
+		return middleware.NewAppError(fiber.StatusUnauthorized, "Unauthorized", nil, nil)
+	}
+
+	var req blockCompanyRequest
+	if err := c.Bind().Body(&req); err != nil {
+		return middleware.NewAppError(fiber.StatusBadRequest, "Bad request", nil, err)
+	}
+
+	it, err := h.uc.BlockCompany(c.Context(), userID, req.CompanyName)
+	if err != nil {
+		return mapUserFeedbackUsecaseError(err)
+	}
+	return response.Success(c, fiber.StatusCreated, "Company blocked successfully", dto.BlockedCompanyResponse{
+		ID:          it.ID,
+		CompanyName: it.CompanyName,
+		BlockedAt:   it.CreatedAt,
+	})
+}
+
+func (h *UserFeedbackHandler) Unblock(c fiber.Ctx) error {
+	userID, ok := c.Locals(middleware.CtxUserIDKey).(uuid.UUID)
+	if !ok {
+		return middleware.NewAppError(fiber.StatusUnauthorized, "Unauthorized", nil, nil)
+	}
+	id, err := uuid.Parse(strings.TrimSpace(c.Params("id")))
+	if err != nil {
+		return middleware.NewAppError(fiber.StatusBadRequest, "Invalid id", nil, err)
+	}
+
+	if err := h.uc.UnblockCompany(c.Context(), userID, id); err != nil {
+		return mapUserFeedbackUsecaseError(err)
+	}
+	return response.Success(c, fiber.StatusOK, "Company unblocked successfully", nil)
+}
+
+func (h *UserFeedbackHandler) ReasonStats(c fiber.Ctx) error {
+	items, err := h.uc.DismissalReasonStats(c.Context(), parseQueryInt(c, "days", 0))
+	if err != nil {
+		return mapUserFeedbackUsecaseError(err)
+	}
+
+	res := make([]dto.DismissalReasonStatResponse, 0, len(items))
+	for _, it := range items {
+		res = append(res, dto.DismissalReasonStatResponse{Reason: it.Reason, Count: it.Count})
+	}
+	return response.Success(c, fiber.StatusOK, response.MessageOK, res)
+}
+
+func mapUserFeedbackUsecaseError(err error) error {
+	if err == nil {
+		return nil
+	}
+
+	switch {
+	case errors.Is(err, usecase.ErrUnauthorized):
+		return middleware.NewAppError(fiber.StatusUnauthorized, "Unauthorized", nil, err)
+	case errors.Is(err, usecase.ErrInvalidInput):
+		return middleware.NewAppError(fiber.StatusBadRequest, "Bad request", nil, err)
+	case errors.Is(err, usecase.ErrJobNotFound):
+		return middleware.NewAppError(fiber.StatusNotFound, "Job not found", nil, err)
+	case errors.Is(err, usecase.ErrFeedbackNotFound):
+		return middleware.NewAppError(fiber.StatusNotFound, "Not found", nil, err)
+	default:
+		return middleware.NewAppError(fiber.StatusInternalServerError, response.MessageInternalServerError, nil, err)
+	}
+}
@@ -0,0 +1,38 @@
+package middleware
+
+import (
+	"strings"
+
+	"github.com/gofiber/fiber/v3"
+)
+
+type AdminMiddleware struct {
+	emails map[string]struct{}
+}
+
+func NewAdminMiddleware(emails []string) *AdminMiddleware {
+	m := &AdminMiddleware{emails: make(map[string]struct{}, len(emails))}
+	for _, e := range emails {
+		e = strings.ToLower(strings.TrimSpace(e))
+		if e == "" {
+			continue
+		}
+		m.emails[e] = struct{}{}
+	}
+	return m
+}
+
+// Middleware must run after AuthMiddleware, which puts the email into Locals.
+func (m *AdminMiddleware) Middleware() fiber.Handler {
+	return func(c fiber.Ctx) error {
+		email, _ := c.Locals(CtxEmailKey).(string)
+		email = strings.ToLower(strings.TrimSpace(email))
+		if email == "" {
+			return NewAppError(fiber.StatusUnauthorized, "Unauthorized", nil, nil)
+		}
+		if _, ok := m.emails[email]; !ok {
+			return NewAppError(fiber.StatusForbidden, "Forbidden", nil, nil)
+		}
+		return c.Next()
+	}
+}
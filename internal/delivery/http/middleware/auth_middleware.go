@@ -48,6 +48,27 @@ func (m *AuthMiddleware) Middleware() fiber.Handler {
 	}
 }
 
+// OptionalMiddleware is for public routes that personalise for signed-in
+// users: a valid access token sets the user like Middleware does, anything
+// else continues anonymously.
+func (m *AuthMiddleware) OptionalMiddleware() fiber.Handler {
+	return func(c fiber.Ctx) error {
+		token, ok := bearerTokenFromHeader(c.Get("Authorization"))
+		if !ok {
+			return c.Next()
+		}
+		claims, err := m.jwt.ValidateToken(token)
+		if err != nil || claims.TokenType != jwt.TokenTypeAccess || m.jwt.IsRefreshToken(claims) {
+			return c.Next()
+		}
+
+		c.Locals(CtxUserIDKey, claims.UserID)
+		c.Locals(CtxEmailKey, claims.Email)
+
+		return c.Next()
+	}
+}
+
 func bearerTokenFromHeader(authHeader string) (string, bool) {
 	authHeader = strings.TrimSpace(authHeader)
 	if authHeader == "" {
@@ -0,0 +1,59 @@
+package middleware
+
+import (
+	"io"
+	"net/http/httptest"
+	"testing"
+	"time"
+
+	"skill-sync/internal/pkg/jwt"
+
+	"github.com/gofiber/fiber/v3"
+	"github.com/google/uuid"
+)
+
+func TestAuthMiddleware_Optional(t *testing.T) {
+	svc := jwt.NewHMACService("access-secret", "refresh-secret", time.Hour, time.Hour)
+	userID := uuid.New()
+	access, err := svc.GenerateAccessToken(userID, "user@example.com")
+	if err != nil {
+		t.Fatal(err)
+	}
+	refresh, err := svc.GenerateRefreshToken(userID)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	app := fiber.New()
+	app.Get("/jobs", NewAuthMiddleware(svc).OptionalMiddleware(), func(c fiber.Ctx) error {
+		id, _ := c.Locals(CtxUserIDKey).(uuid.UUID)
+		return c.SendString(id.String())
+	})
+
+	cases := []struct {
+		name   string
+		header string
+		want   uuid.UUID
+	}{
+		{name: "anonymous", want: uuid.Nil},
+		{name: "access token", header: "Bearer " + access, want: userID},
+		{name: "refresh token", header: "Bearer " + refresh, want: uuid.Nil},
+		{name: "invalid token", header: "Bearer nope", want: uuid.Nil},
+	}
+	for _, tc := range cases {
+		t.Run(tc.name, func(t *testing.T) {
+			req := httptest.NewRequest("GET", "/jobs", nil)
+			if tc.header != "" {
+				req.Header.Set("Authorization", tc.header)
+			}
+			resp, err := app.Test(req)
+			if err != nil {
+				t.Fatal(err)
+			}
+			body, _ := io.ReadAll(resp.Body)
+			if resp.StatusCode != fiber.StatusOK || string(body) != tc.want.String() {
+				t.Fatalf("status %d, user %q; want 200, %q", resp.StatusCode, body, tc.want)
+			}
+		})
+	}
+}
@@ -1,12 +1,17 @@
 package routes
 
 import (
+	"context"
 	"log"
 	"skill-sync/internal/config"
 	"skill-sync/internal/database"
 	"skill-sync/internal/delivery/http/handler"
 	"skill-sync/internal/infrastructure/cache"
+	"skill-sync/internal/pkg/jwt"
+	"skill-sync/internal/repository"
+	"skill-sync/internal/usecase"
 	"skill-sync/internal/ws"
+	"time"
 
 	"github.com/gofiber/fiber/v3"
 )
@@ -29,8 +34,15 @@ func (r *Registry) Register(app *fiber.App) {
 	wsHub := ws.NewHub(log.Default())
 	ws.SetDefaultHub(wsHub)
 	go wsHub.Run()
-	wsHandler := ws.NewHandler(wsHub, log.Default())
+	jwtSvc := jwt.NewHMACService(
+		r.cfg.JWT.AccessSecret,
+		r.cfg.JWT.RefreshSecret,
+		r.cfg.JWT.AccessExpiresIn,
+		r.cfg.JWT.RefreshExpiresIn,
+	)
+	wsHandler := ws.NewHandler(wsHub, jwtSvc, log.Default())
 	app.Get("/ws/jobs", wsHandler.HandleJobsWS)
+	app.Get("/ws/alerts", wsHandler.HandleUserWS)
 
 	r.registerHealth(app)
 	r.registerInternal(app)
@@ -49,7 +61,15 @@ func (r *Registry) registerAPI(app *fiber.App) {
 func (r *Registry) registerInternal(app *fiber.App) {
 	logger := log.Default()
 	redisCache := cache.NewRedis(logger)
-	internalHandler := handler.NewScrapeCompletedHandler(r.cfg, redisCache, logger)
+	alerts := usecase.NewSavedSearchAlerts(
+		repository.NewPostgresSavedSearchRepository(r.db),
+		repository.NewPostgresJobRepository(r.db),
+		usecase.NewUserFeedbackUsecase(repository.NewPostgresUserFeedbackRepository(r.db)),
+		ws.Notifier{},
+		logger,
+	)
+	go alerts.Run(context.Background(), time.Duration(r.cfg.SavedSearchAlertMinutes)*time.Minute)
+	internalHandler := handler.NewScrapeCompletedHandler(r.cfg, redisCache, alerts, logger)
 
 	internal := app.Group("/internal")
 	internal.Post("/scrape-completed", internalHandler.HandleScrapeCompleted)
@@ -1,19 +1,23 @@
 package v1
 
 import (
+	"context"
 	"log"
 	"os"
 	"strings"
+	"time"
 
 	"skill-sync/internal/config"
 	"skill-sync/internal/database"
 	"skill-sync/internal/delivery/http/handler"
 	"skill-sync/internal/delivery/http/middleware"
+	"skill-sync/internal/domain/matching"
 	"skill-sync/internal/infrastructure/cache"
 	"skill-sync/internal/infrastructure/persistence/postgres"
 	"skill-sync/internal/infrastructure/scraper"
 	"skill-sync/internal/pkg/jwt"
 	"skill-sync/internal/repository"
+	"skill-sync/internal/search"
 	"skill-sync/internal/usecase"
 	jobuc "skill-sync/internal/usecase/job"
 
@@ -33,6 +37,7 @@ func Register(r fiber.Router, cfg config.Config, db database.DB) {
 	)
 
 	authMw := middleware.NewAuthMiddleware(jwtSvc)
+	adminMw := middleware.NewAdminMiddleware(cfg.AdminEmails)
 
 	skillRepo := repository.NewPostgresSkillRepository(db)
 
@@ -43,18 +48,75 @@ func Register(r fiber.Router, cfg config.Config, db database.DB) {
 	jobSkillV2Repo := repository.NewPostgresJobSkillV2Repository(db)
 	pipelineStatusRepo := repository.NewPostgresPipelineStatusRepository(db)
 	pipelineRepo := repository.NewPostgresPipelineRepository(db)
+	scoringProfileRepo := repository.NewPostgresScoringProfileRepository(db)
+	skillRelationRepo := repository.NewPostgresSkillRelationRepository(db)
+	jobMatchRepo := repository.NewPostgresJobMatchRepository(db)
+	jobQueryRepo := repository.NewPostgresJobQueryRepository(db)
+	matchDirtyRepo := repository.NewPostgresMatchDirtyRepository(db)
+	seniorityRepo := repository.NewPostgresSeniorityRepository(db)
+	userFeedbackRepo := repository.NewPostgresUserFeedbackRepository(db)
+	applicationRepo := repository.NewPostgresApplicationRepository(db)
+	savedSearchRepo := repository.NewPostgresSavedSearchRepository(db)
+	matchHistoryRepo := repository.NewPostgresMatchHistoryRepository(db)
+	searchSynonymRepo := repository.NewPostgresSearchSynonymRepository(db)
+	searchVocabularyRepo := repository.NewPostgresSearchVocabularyRepository(db)
+	searchSuggestRepo := repository.NewPostgresSearchSuggestRepository(db)
+	jobSourceRepo := repository.NewPostgresJobSourceRepository(db)
 
 	logger := log.Default()
+	search.SetWeights(search.Weights{
+		Relevance: cfg.Ranking.RelevanceWeight,
+		TextRank:  cfg.Ranking.TextRankWeight,
+		Freshness: cfg.Ranking.FreshnessWeight,
+		Source:    cfg.Ranking.SourceWeight,
+		Quality:   cfg.Ranking.QualityWeight,
+		Match:     cfg.Ranking.MatchWeight,
+		Proximity: cfg.Ranking.ProximityWeight,
+	})
 	redisCache := cache.NewRedis(logger)
 	scraperClient := scraper.NewScraperClient(cfg.ScraperBaseURL, logger)
 	freshnessSvc := jobuc.NewFreshnessService(jobRepo, scraperClient, redisCache, logger, cfg.SearchFreshnessMinutes)
+	scoringProfiles := usecase.NewScoringProfiles(scoringProfileRepo, matching.NewProfileRegistry(), logger)
+	loadCtx, cancelLoad := context.WithTimeout(context.Background(), 5*time.Second)
+	scoringProfiles.Load(loadCtx, cfg.MatchingProfile)
+	skillRelationUC := usecase.NewSkillRelationUsecase(skillRelationRepo, matchDirtyRepo, logger)
+	_ = skillRelationUC.Reload(loadCtx)
+	searchSynonymUC := usecase.NewSearchSynonymUsecase(searchSynonymRepo, redisCache, logger)
+	_ = searchSynonymUC.Reload(loadCtx)
+	searchVocabulary := usecase.NewSearchVocabulary(searchVocabularyRepo, logger)
+	_ = searchVocabulary.Reload(loadCtx)
+	searchSuggestUC := usecase.NewSearchSuggestUsecase(searchSuggestRepo, redisCache, logger)
+	_ = searchSuggestUC.Rebuild(loadCtx)
+	cancelLoad()
+	go searchSynonymUC.Run(context.Background(), time.Duration(cfg.SearchSynonymReloadSeconds)*time.Second)
+	go searchVocabulary.Run(context.Background(), time.Duration(cfg.SearchVocabularyReloadMinutes)*time.Minute)
+	go searchSuggestUC.Run(context.Background(), time.Duration(cfg.SearchSuggestRefreshSeconds)*time.Second)
+
 	authUC := usecase.NewAuthUsecase(userRepo, jwtSvc)
-	userUC := usecase.NewUserUsecase(userRepo)
-	userSkillUC := usecase.NewUserSkillUsecase(userSkillRepo)
+	userUC := usecase.NewUserUsecase(userRepo, matchDirtyRepo)
+	userSkillUC := usecase.NewUserSkillUsecase(userSkillRepo, matchDirtyRepo)
 	skillUC := usecase.NewSkillUsecase(skillRepo)
-	jobRecommendationUC := usecase.NewJobRecommendationUsecase(jobRepo, jobSkillRepo, userSkillRepo)
-	matchingV2UC := usecase.NewMatchingUsecaseV2(jobRepo, jobSkillV2Repo, userSkillRepo)
-	jobListUC := usecase.NewJobListUsecase(jobRepo, jobSkillRepo, freshnessSvc, redisCache, logger)
+	userFeedbackUC := usecase.NewUserFeedbackUsecase(userFeedbackRepo)
+	matchingV2UC := usecase.NewMatchingUsecaseV2(jobRepo, jobSkillV2Repo, userSkillRepo, scoringProfiles.Registry(), skillRelationUC, seniorityRepo)
+	jobRecommendationUC := usecase.NewJobRecommendationUsecase(
+		jobRepo,
+		jobQueryRepo,
+		jobSkillV2Repo,
+		userSkillRepo,
+		jobMatchRepo,
+		matchDirtyRepo,
+		seniorityRepo,
+		matchingV2UC,
+		userFeedbackUC,
+		time.Duration(cfg.RecommendationMatchTTLMinutes)*time.Minute,
+	)
+	applicationUC := usecase.NewApplicationUsecase(applicationRepo, matchingV2UC)
+	savedSearchUC := usecase.NewSavedSearchUsecase(savedSearchRepo)
+	progressUC := usecase.NewProgressUsecase(matchHistoryRepo)
+	skillGapUC := usecase.NewSkillGapUsecase(jobMatchRepo, jobQueryRepo, jobRepo, jobSkillV2Repo, userSkillRepo, matchingV2UC, seniorityRepo)
+	jobPersonalizer := usecase.NewJobPersonalizer(userSkillRepo, jobSkillV2Repo, seniorityRepo, matchingV2UC)
+	jobListUC := usecase.NewJobListUsecase(jobRepo, jobSkillRepo, freshnessSvc, redisCache, userFeedbackUC, searchVocabulary, jobPersonalizer, logger)
+	jobSourceUC := usecase.NewJobSourceUsecase(jobSourceRepo, redisCache, logger)
 	pipelineStatusUC := usecase.NewPipelineStatusUsecase(pipelineStatusRepo, nil)
 	pipelineUC := usecase.NewPipelineUsecase(pipelineRepo, db, redisCache)
 
@@ -64,9 +126,17 @@ func Register(r fiber.Router, cfg config.Config, db database.DB) {
 	skillHandler := handler.NewSkillHandler(skillUC)
 	jobRecommendationHandler := handler.NewJobRecommendationHandler(jobRecommendationUC)
 	matchV2Handler := handler.NewMatchV2Handler(matchingV2UC)
-	jobsHandler := handler.NewJobsHandler(jobListUC)
+	jobsHandler := handler.NewJobsHandler(jobListUC, searchSuggestUC)
 	pipelineStatusHandler := handler.NewPipelineStatusHandler(pipelineStatusUC, nil)
 	pipelineHandler := handler.NewPipelineHandler(pipelineUC)
+	adminSkillRelationHandler := handler.NewAdminSkillRelationHandler(skillRelationUC)
+	adminSearchSynonymHandler := handler.NewAdminSearchSynonymHandler(searchSynonymUC)
+	adminJobSourceHandler := handler.NewAdminJobSourceHandler(jobSourceUC)
+	skillGapHandler := handler.NewSkillGapHandler(skillGapUC)
+	userFeedbackHandler := handler.NewUserFeedbackHandler(userFeedbackUC)
+	applicationHandler := handler.NewApplicationHandler(applicationUC)
+	savedSearchHandler := handler.NewSavedSearchHandler(savedSearchUC)
+	progressHandler := handler.NewProgressHandler(progressUC)
 
 	authGroup := r.Group("/auth")
 	authHandler.RegisterRoutes(authGroup)
@@ -76,15 +146,30 @@ func Register(r fiber.Router, cfg config.Config, db database.DB) {
 
 	publicJobs := strings.EqualFold(strings.TrimSpace(os.Getenv("PUBLIC_JOBS")), "true")
 	if publicJobs {
-		r.Get("/jobs", jobsHandler.HandleListJobs)
+		// Signed-in users still get their exclusions and personalisation.
+		r.Get("/jobs", authMw.OptionalMiddleware(), jobsHandler.HandleListJobs)
+		r.Get("/jobs/suggest", jobsHandler.HandleSuggest)
 	} else {
 		protected.Get("/jobs", jobsHandler.HandleListJobs)
+		protected.Get("/jobs/suggest", jobsHandler.HandleSuggest)
 	}
 
 	usersGroup := protected.Group("/users")
 	RegisterUsers(usersGroup, userHandler, userSkillHandler)
+	skillGapHandler.RegisterRoutes(usersGroup)
+	userFeedbackHandler.RegisterRoutes(usersGroup)
+	applicationHandler.RegisterRoutes(usersGroup)
+	savedSearchHandler.RegisterRoutes(usersGroup)
+	progressHandler.RegisterRoutes(usersGroup)
+	userFeedbackHandler.RegisterJobRoutes(protected)
 	RegisterJobs(protected, jobRecommendationHandler)
 	matchV2Handler.RegisterRoutes(protected)
 	pipelineStatusHandler.RegisterRoutes(protected)
 	pipelineHandler.RegisterRoutes(protected)
+
+	adminGroup := protected.Group("/admin", adminMw.Middleware())
+	adminSkillRelationHandler.RegisterRoutes(adminGroup)
+	adminSearchSynonymHandler.RegisterRoutes(adminGroup)
+	adminJobSourceHandler.RegisterRoutes(adminGroup)
+	userFeedbackHandler.RegisterAdminRoutes(adminGroup)
 }
@@ -0,0 +1,202 @@
+package job
+
+import (
+	"strings"
+	"unicode"
+)
+
+const (
+	LocationProvince = "province"
+	LocationCity     = "city"
+	LocationDistrict = "district"
+	LocationRemote   = "remote"
+)
+
+// Location is a gazetteer entry. IDs are slash-separated slugs from the
+// province down ("dki-jakarta/jakarta-selatan"), so a place covers every ID it
+// is a prefix of.
+type Location struct {
+	ID       string
+	Name     string
+	Level    string
+	ParentID string
+}
+
+// Covers reports whether id is this location or lies inside it.
+func (l Location) Covers(id string) bool {
+	return id == l.ID || strings.HasPrefix(id, l.ID+"/")
+}
+
+type place struct {
+	name     string
+	aliases  []string
+	children []place
+}
+
+// maxLocationAliasWords is the longest alias, "daerah khusus ibukota jakarta".
+const maxLocationAliasWords = 4
+
+var (
+	locationsByID    = map[string]Location{}
+	locationsByAlias = map[string]Location{}
+)
+
+func init() {
+	type entry struct {
+		p      place
+		parent string
+	}
+	levels := []string{LocationProvince, LocationCity, LocationDistrict}
+	// Breadth-first, so when an alias is shared the broader place keeps it.
+	next := make([]entry, 0, len(gazetteer))
+	for _, p := range gazetteer {
+		next = append(next, entry{p: p})
+	}
+	for depth := 0; len(next) > 0 && depth < len(levels); depth++ {
+		current := next
+		next = nil
+		for _, e := range current {
+			id := locationSlug(e.p.name)
+			if e.parent != "" {
+				id = e.parent + "/" + id
+			}
+			addLocation(Location{ID: id, Name: e.p.name, Level: levels[depth], ParentID: e.parent}, e.p.aliases)
+			for _, c := range e.p.children {
+				next = append(next, entry{p: c, parent: id})
+			}
+		}
+	}
+	addLocation(Location{ID: "remote", Name: remotePlace.name, Level: LocationRemote}, remotePlace.aliases)
+}
+
+func addLocation(loc Location, aliases []string) {
+	locationsByID[loc.ID] = loc
+	name := normalizeLocationText(loc.Name)
+	all := append([]string{name}, aliases...)
+	switch loc.Level {
+	case LocationProvince:
+		all = append(all, "provinsi "+name)
+	case LocationCity:
+		if rest, ok := strings.CutPrefix(name, "kabupaten "); ok {
+			all = append(all, "kab "+rest)
+		} else if !strings.HasPrefix(name, "kota ") {
+			all = append(all, "kota "+name)
+		}
+	}
+	for _, a := range all {
+		a = normalizeLocationText(a)
+		if _, taken := locationsByAlias[a]; a != "" && !taken {
+			locationsByAlias[a] = loc
+		}
+	}
+}
+
+// LocationByID returns the gazetteer entry for a canonical location ID.
+func LocationByID(id string) (Location, bool) {
+	loc, ok := locationsByID[id]
+	return loc, ok
+}
+
+// LookupLocation matches a whole name or alias ("jaksel", "kota bandung").
+func LookupLocation(name string) (Location, bool) {
+	loc, ok := locationsByAlias[normalizeLocationText(name)]
+	return loc, ok
+}
+
+// ResolveLocation maps a scraped location string ("Kota Jakarta Selatan,
+// Jakarta Raya", "South Jakarta", "Remote") to the most specific gazetteer
+// entry it names.
+func ResolveLocation(raw string) (Location, bool) {
+	var best Location
+	found := false
+	for _, seg := range locationSegments(raw) {
+		loc, ok := resolveLocationSegment(seg)
+		if ok && (!found || locationDepth(loc) > locationDepth(best)) {
+			best, found = loc, true
+		}
+	}
+	return best, found
+}
+
+// locationSegments splits on the separators scraped locations use between
+// levels ("Tebet, Jakarta Selatan", "Jakarta - Hybrid").
+func locationSegments(raw string) []string {
+	raw = strings.ReplaceAll(raw, " - ", ",")
+	return strings.FieldsFunc(raw, func(r rune) bool {
+		return strings.ContainsRune(",;/|()•", r)
+	})
+}
+
+var (
+	locationPrefixWords = map[string]struct{}{"kota": {}, "kabupaten": {}, "kab": {}, "kecamatan": {}, "kec": {}, "provinsi": {}, "prov": {}}
+	locationSuffixWords = map[string]struct{}{"city": {}, "regency": {}, "province": {}, "district": {}, "area": {}, "indonesia": {}}
+)
+
+func resolveLocationSegment(seg string) (Location, bool) {
+	words := strings.Fields(normalizeLocationText(seg))
+	if len(words) == 0 {
+		return Location{}, false
+	}
+	if loc, ok := locationsByAlias[strings.Join(words, " ")]; ok {
+		return loc, true
+	}
+	stripped := words
+	for len(stripped) > 1 {
+		if _, ok := locationPrefixWords[stripped[0]]; !ok {
+			break
+		}
+		stripped = stripped[1:]
+	}
+	for len(stripped) > 1 {
+		if _, ok := locationSuffixWords[stripped[len(stripped)-1]]; !ok {
+			break
+		}
+		stripped = stripped[:len(stripped)-1]
+	}
+	if loc, ok := locationsByAlias[strings.Join(stripped, " ")]; ok {
+		return loc, true
+	}
+
+	// Otherwise take the most specific name anywhere in the segment
+	// ("hybrid jakarta selatan office").
+	var best Location
+	found := false
+	for i := range words {
+		for n := min(maxLocationAliasWords, len(words)-i); n > 0; n-- {
+			loc, ok := locationsByAlias[strings.Join(words[i:i+n], " ")]
+			if !ok {
+				continue
+			}
+			if !found || locationDepth(loc) > locationDepth(best) {
+				best, found = loc, true
+			}
+			break
+		}
+	}
+	return best, found
+}
+
+func locationDepth(l Location) int {
+	return strings.Count(l.ID, "/") + 1
+}
+
+func normalizeLocationText(s string) string {
+	s = strings.Map(func(r rune) rune {
+		if unicode.IsLetter(r) || unicode.IsNumber(r) {
+			return unicode.ToLower(r)
+		}
+		return ' '
+	}, s)
+	return strings.Join(strings.Fields(s), " ")
+}
+
+func locationSlug(name string) string {
+	return strings.ReplaceAll(normalizeLocationText(name), " ", "-")
+}
+
+// LocationID is the canonical ID for a scraped location, or "" when it is not
+// recognised.
+func LocationID(raw string) string {
+	loc, _ := ResolveLocation(raw)
+	return loc.ID
+}
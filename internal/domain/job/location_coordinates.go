@@ -0,0 +1,222 @@
+package job
+
+import (
+	"math"
+	"sort"
+)
+
+type Coordinates struct {
+	Lat float64
+	Lon float64
+}
+
+// locationCoordinates are approximate city centres, keyed by location ID.
+// Provinces sit at their capital, Jakarta at Monas. Places missing here use
+// their nearest listed ancestor; capitals are listed themselves so they are
+// not mistaken for the province in radius searches.
+var locationCoordinates = map[string]Coordinates{
+	"aceh":                                     {5.5483, 95.3238},
+	"aceh/banda-aceh":                          {5.5483, 95.3238},
+	"aceh/lhokseumawe":                         {5.1801, 97.1507},
+	"sumatera-utara":                           {3.5952, 98.6722},
+	"sumatera-utara/medan":                     {3.5952, 98.6722},
+	"sumatera-utara/binjai":                    {3.6001, 98.4854},
+	"sumatera-utara/pematangsiantar":           {2.9595, 99.0687},
+	"sumatera-utara/kabupaten-deli-serdang":    {3.5530, 98.8740},
+	"sumatera-barat":                           {-0.9471, 100.4172},
+	"sumatera-barat/padang":                    {-0.9471, 100.4172},
+	"sumatera-barat/bukittinggi":               {-0.3050, 100.3692},
+	"riau":                                     {0.5071, 101.4478},
+	"riau/pekanbaru":                           {0.5071, 101.4478},
+	"riau/dumai":                               {1.6666, 101.4001},
+	"kepulauan-riau":                           {0.9186, 104.4554},
+	"kepulauan-riau/batam":                     {1.0456, 104.0305},
+	"kepulauan-riau/tanjung-pinang":            {0.9186, 104.4554},
+	"jambi":                                    {-1.6101, 103.6131},
+	"jambi/jambi":                              {-1.6101, 103.6131},
+	"sumatera-selatan":                         {-2.9761, 104.7754},
+	"sumatera-selatan/palembang":               {-2.9761, 104.7754},
+	"kepulauan-bangka-belitung":                {-2.1316, 106.1169},
+	"kepulauan-bangka-belitung/pangkal-pinang": {-2.1316, 106.1169},
+	"bengkulu":                                 {-3.8004, 102.2655},
+	"bengkulu/bengkulu":                        {-3.8004, 102.2655},
+	"lampung":                                  {-5.3971, 105.2668},
+	"lampung/bandar-lampung":                   {-5.3971, 105.2668},
+	"lampung/kota-metro":                       {-5.1131, 105.3067},
+	"dki-jakarta":                              {-6.1754, 106.8272},
+	"dki-jakarta/jakarta-selatan":              {-6.2615, 106.8106},
+	"dki-jakarta/jakarta-pusat":                {-6.1865, 106.8341},
+	"dki-jakarta/jakarta-barat":                {-6.1674, 106.7637},
+	"dki-jakarta/jakarta-timur":                {-6.2250, 106.9004},
+	"dki-jakarta/jakarta-utara":                {-6.1381, 106.8636},
+	"dki-jakarta/kepulauan-seribu":             {-5.7985, 106.5072},
+	"jawa-barat":                               {-6.9175, 107.6191},
+	"jawa-barat/bandung":                       {-6.9175, 107.6191},
+	"jawa-barat/cimahi":                        {-6.8722, 107.5425},
+	"jawa-barat/bekasi":                        {-6.2383, 106.9756},
+	"jawa-barat/kabupaten-bekasi":              {-6.3106, 107.1380},
+	"jawa-barat/kabupaten-bekasi/cikarang":     {-6.2610, 107.1526},
+	"jawa-barat/bogor":                         {-6.5971, 106.8060},
+	"jawa-barat/depok":                         {-6.4025, 106.7942},
+	"jawa-barat/cirebon":                       {-6.7320, 108.5523},
+	"jawa-barat/sukabumi":                      {-6.9277, 106.9300},
+	"jawa-barat/tasikmalaya":                   {-7.3274, 108.2207},
+	"jawa-barat/kabupaten-karawang":            {-6.3227, 107.3376},
+	"jawa-barat/kabupaten-purwakarta":          {-6.5569, 107.4431},
+	"banten":                                   {-6.1200, 106.1503},
+	"banten/tangerang":                         {-6.1783, 106.6319},
+	"banten/tangerang-selatan":                 {-6.2886, 106.7179},
+	"banten/tangerang-selatan/serpong":         {-6.3004, 106.6522},
+	"banten/tangerang-selatan/ciputat":         {-6.3125, 106.7560},
+	"banten/tangerang-selatan/pamulang":        {-6.3428, 106.7385},
+	"banten/serang":                            {-6.1200, 106.1503},
+	"banten/cilegon":                           {-6.0025, 106.0111},
+	"jawa-tengah":                              {-6.9667, 110.4167},
+	"jawa-tengah/semarang":                     {-6.9667, 110.4167},
+	"jawa-tengah/surakarta":                    {-7.5755, 110.8243},
+	"jawa-tengah/magelang":                     {-7.4797, 110.2177},
+	"jawa-tengah/pekalongan":                   {-6.8886, 109.6753},
+	"jawa-tengah/tegal":                        {-6.8694, 109.1402},
+	"jawa-tengah/salatiga":                     {-7.3305, 110.5084},
+	"jawa-tengah/kabupaten-kudus":              {-6.8048, 110.8405},
+	"di-yogyakarta":                            {-7.7956, 110.3695},
+	"di-yogyakarta/yogyakarta":                 {-7.7956, 110.3695},
+	"di-yogyakarta/kabupaten-sleman":           {-7.7167, 110.3556},
+	"di-yogyakarta/kabupaten-bantul":           {-7.8881, 110.3289},
+	"jawa-timur":                               {-7.2575, 112.7521},
+	"jawa-timur/surabaya":                      {-7.2575, 112.7521},
+	"jawa-timur/malang":                        {-7.9666, 112.6326},
+	"jawa-timur/kabupaten-sidoarjo":            {-7.4478, 112.7183},
+	"jawa-timur/kabupaten-gresik":              {-7.1569, 112.6556},
+	"jawa-timur/kediri":                        {-7.8480, 112.0178},
+	"jawa-timur/madiun":                        {-7.6298, 111.5239},
+	"jawa-timur/kabupaten-jember":              {-8.1724, 113.7005},
+	"jawa-timur/mojokerto":                     {-7.4722, 112.4338},
+	"jawa-timur/pasuruan":                      {-7.6453, 112.9075},
+	"jawa-timur/probolinggo":                   {-7.7543, 113.2159},
+	"jawa-timur/kota-batu":                     {-7.8671, 112.5239},
+	"bali":                                     {-8.6705, 115.2126},
+	"bali/denpasar":                            {-8.6705, 115.2126},
+	"bali/kabupaten-badung":                    {-8.5819, 115.1770},
+	"bali/kabupaten-gianyar":                   {-8.5367, 115.3331},
+	"nusa-tenggara-barat":                      {-8.5833, 116.1167},
+	"nusa-tenggara-barat/mataram":              {-8.5833, 116.1167},
+	"nusa-tenggara-timur":                      {-10.1772, 123.6070},
+	"nusa-tenggara-timur/kupang":               {-10.1772, 123.6070},
+	"kalimantan-barat":                         {-0.0263, 109.3425},
+	"kalimantan-barat/pontianak":               {-0.0263, 109.3425},
+	"kalimantan-tengah":                        {-2.2161, 113.9135},
+	"kalimantan-tengah/palangka-raya":          {-2.2161, 113.9135},
+	"kalimantan-selatan":                       {-3.4572, 114.8103},
+	"kalimantan-selatan/banjarbaru":            {-3.4572, 114.8103},
+	"kalimantan-selatan/banjarmasin":           {-3.3186, 114.5944},
+	"kalimantan-timur":                         {-0.5022, 117.1536},
+	"kalimantan-timur/samarinda":               {-0.5022, 117.1536},
+	"kalimantan-timur/balikpapan":              {-1.2379, 116.8529},
+	"kalimantan-timur/bontang":                 {0.1333, 117.5000},
+	"kalimantan-utara":                         {2.8375, 117.3653},
+	"kalimantan-utara/tanjung-selor":           {2.8375, 117.3653},
+	"kalimantan-utara/tarakan":                 {3.3000, 117.6333},
+	"sulawesi-utara":                           {1.4748, 124.8421},
+	"sulawesi-utara/manado":                    {1.4748, 124.8421},
+	"sulawesi-utara/bitung":                    {1.4404, 125.1217},
+	"gorontalo":                                {0.5435, 123.0568},
+	"gorontalo/gorontalo":                      {0.5435, 123.0568},
+	"sulawesi-tengah":                          {-0.8917, 119.8707},
+	"sulawesi-tengah/palu":                     {-0.8917, 119.8707},
+	"sulawesi-barat":                           {-2.6786, 118.8933},
+	"sulawesi-barat/mamuju":                    {-2.6786, 118.8933},
+	"sulawesi-selatan":                         {-5.1477, 119.4327},
+	"sulawesi-selatan/makassar":                {-5.1477, 119.4327},
+	"sulawesi-selatan/parepare":                {-4.0135, 119.6255},
+	"sulawesi-tenggara":                        {-3.9985, 122.5130},
+	"sulawesi-tenggara/kendari":                {-3.9985, 122.5130},
+	"maluku":                                   {-3.6954, 128.1814},
+	"maluku/ambon":                             {-3.6954, 128.1814},
+	"maluku-utara":                             {0.7333, 127.5667},
+	"maluku-utara/sofifi":                      {0.7333, 127.5667},
+	"maluku-utara/ternate":                     {0.7893, 127.3770},
+	"papua":                                    {-2.5337, 140.7181},
+	"papua/jayapura":                           {-2.5337, 140.7181},
+	"papua-barat":                              {-0.8615, 134.0620},
+	"papua-barat/manokwari":                    {-0.8615, 134.0620},
+	"papua-barat-daya":                         {-0.8762, 131.2558},
+	"papua-barat-daya/sorong":                  {-0.8762, 131.2558},
+	"papua-selatan":                            {-8.4932, 140.4018},
+	"papua-selatan/merauke":                    {-8.4932, 140.4018},
+	"papua-tengah":                             {-3.3667, 135.4833},
+	"papua-tengah/nabire":                      {-3.3667, 135.4833},
+	"papua-pegunungan":                         {-4.0928, 138.9440},
+	"papua-pegunungan/kabupaten-jayawijaya":    {-4.0928, 138.9440},
+}
+
+// LocationCoordinates returns the coordinates of a location ID, falling back
+// to the nearest ancestor that has them. Remote has none.
+func LocationCoordinates(id string) (Coordinates, bool) {
+	_, c, ok := coordinateSource(id)
+	return c, ok
+}
+
+// coordinateSource returns the location whose coordinates id uses: itself or
+// its nearest listed ancestor.
+func coordinateSource(id string) (string, Coordinates, bool) {
+	for id != "" {
+		if c, ok := locationCoordinates[id]; ok {
+			return id, c, true
+		}
+		loc, ok := locationsByID[id]
+		if !ok {
+			return "", Coordinates{}, false
+		}
+		id = loc.ParentID
+	}
+	return "", Coordinates{}, false
+}
+
+const earthRadiusKm = 6371.0
+
+// DistanceKm is the great-circle distance between two points.
+func DistanceKm(a, b Coordinates) float64 {
+	lat1, lat2 := a.Lat*math.Pi/180, b.Lat*math.Pi/180
+	dLat := lat2 - lat1
+	dLon := (b.Lon - a.Lon) * math.Pi / 180
+	h := math.Sin(dLat/2)*math.Sin(dLat/2) + math.Cos(lat1)*math.Cos(lat2)*math.Sin(dLon/2)*math.Sin(dLon/2)
+	return 2 * earthRadiusKm * math.Asin(math.Min(1, math.Sqrt(h)))
+}
+
+// LocationDistance is a location and its distance from a search centre.
+type LocationDistance struct {
+	ID string
+	Km float64
+}
+
+// pointProvinces are provinces small enough to stand at a single point; jobs
+// filed under just "Jakarta" are as good as placed at Monas.
+var pointProvinces = map[string]bool{"dki-jakarta": true}
+
+// LocationsWithin lists the gazetteer locations within radiusKm of center,
+// nearest first. Provinces, and places that only inherit a province's
+// coordinates, are left out: a job tagged "Jawa Barat" may be anywhere in it,
+// so placing it at Bandung would pass every Bandung radius search.
+func LocationsWithin(center Coordinates, radiusKm float64) []LocationDistance {
+	out := make([]LocationDistance, 0)
+	for id := range locationsByID {
+		src, c, ok := coordinateSource(id)
+		if !ok {
+			continue
+		}
+		if loc := locationsByID[src]; loc.Level == LocationProvince && !pointProvinces[src] {
+			continue
+		}
+		if d := DistanceKm(center, c); d <= radiusKm {
+			out = append(out, LocationDistance{ID: id, Km: d})
+		}
+	}
+	sort.Slice(out, func(i, j int) bool {
+		if out[i].Km != out[j].Km {
+			return out[i].Km < out[j].Km
+		}
+		return out[i].ID < out[j].ID
+	})
+	return out
+}
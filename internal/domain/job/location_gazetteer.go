@@ -0,0 +1,233 @@
+package job
+
+// gazetteer lists Indonesian provinces, their main cities and regencies and,
+// for the largest job markets, districts. Names double as aliases; cities also
+// answer to "kota <name>" and provinces to "provinsi <name>". When a name
+// belongs to both a province and its capital ("Jambi"), it resolves to the
+// province and the city is reached through "kota jambi". Cities named after
+// common words keep "Kota" in their name so the bare word never matches.
+var gazetteer = []place{
+	{name: "Aceh", aliases: []string{"nanggroe aceh darussalam", "nad"}, children: []place{
+		{name: "Banda Aceh"},
+		{name: "Lhokseumawe"},
+	}},
+	{name: "Sumatera Utara", aliases: []string{"sumut", "north sumatra", "north sumatera", "sumatra utara"}, children: []place{
+		{name: "Medan"},
+		{name: "Binjai"},
+		{name: "Pematangsiantar", aliases: []string{"pematang siantar", "siantar"}},
+		{name: "Kabupaten Deli Serdang", aliases: []string{"deli serdang"}},
+	}},
+	{name: "Sumatera Barat", aliases: []string{"sumbar", "west sumatra", "west sumatera", "sumatra barat"}, children: []place{
+		{name: "Padang"},
+		{name: "Bukittinggi"},
+	}},
+	{name: "Riau", children: []place{
+		{name: "Pekanbaru"},
+		{name: "Dumai"},
+	}},
+	{name: "Kepulauan Riau", aliases: []string{"kepri", "riau islands"}, children: []place{
+		{name: "Batam"},
+		{name: "Tanjung Pinang", aliases: []string{"tanjungpinang"}},
+	}},
+	{name: "Jambi", children: []place{
+		{name: "Jambi"},
+	}},
+	{name: "Sumatera Selatan", aliases: []string{"sumsel", "south sumatra", "south sumatera", "sumatra selatan"}, children: []place{
+		{name: "Palembang"},
+	}},
+	{name: "Kepulauan Bangka Belitung", aliases: []string{"bangka belitung", "babel"}, children: []place{
+		{name: "Pangkal Pinang", aliases: []string{"pangkalpinang"}},
+	}},
+	{name: "Bengkulu", children: []place{
+		{name: "Bengkulu"},
+	}},
+	{name: "Lampung", children: []place{
+		{name: "Bandar Lampung"},
+		{name: "Kota Metro"},
+	}},
+	{name: "DKI Jakarta", aliases: []string{"jakarta", "jakarta raya", "daerah khusus ibukota jakarta"}, children: []place{
+		{name: "Jakarta Selatan", aliases: []string{"south jakarta", "jaksel"}, children: []place{
+			{name: "Kebayoran Baru", aliases: []string{"scbd"}},
+			{name: "Kebayoran Lama"},
+			{name: "Pesanggrahan"},
+			{name: "Cilandak"},
+			{name: "Pasar Minggu"},
+			{name: "Jagakarsa"},
+			{name: "Mampang Prapatan", aliases: []string{"mampang"}},
+			{name: "Pancoran"},
+			{name: "Tebet"},
+			{name: "Setiabudi", aliases: []string{"setia budi"}},
+		}},
+		{name: "Jakarta Pusat", aliases: []string{"central jakarta", "jakpus"}, children: []place{
+			{name: "Gambir"},
+			{name: "Tanah Abang"},
+			{name: "Menteng"},
+			{name: "Senen"},
+			{name: "Cempaka Putih"},
+			{name: "Johar Baru"},
+			{name: "Kemayoran"},
+			{name: "Sawah Besar"},
+		}},
+		{name: "Jakarta Barat", aliases: []string{"west jakarta", "jakbar"}, children: []place{
+			{name: "Cengkareng"},
+			{name: "Grogol Petamburan", aliases: []string{"grogol"}},
+			{name: "Taman Sari"},
+			{name: "Tambora"},
+			{name: "Kebon Jeruk"},
+			{name: "Kalideres"},
+			{name: "Palmerah"},
+			{name: "Kembangan"},
+		}},
+		{name: "Jakarta Timur", aliases: []string{"east jakarta", "jaktim"}, children: []place{
+			{name: "Matraman"},
+			{name: "Pulo Gadung", aliases: []string{"pulogadung"}},
+			{name: "Jatinegara"},
+			{name: "Kramat Jati"},
+			{name: "Pasar Rebo"},
+			{name: "Cakung"},
+			{name: "Duren Sawit"},
+			{name: "Makasar"},
+			{name: "Ciracas"},
+			{name: "Cipayung"},
+		}},
+		{name: "Jakarta Utara", aliases: []string{"north jakarta", "jakut"}, children: []place{
+			{name: "Penjaringan"},
+			{name: "Pademangan"},
+			{name: "Tanjung Priok"},
+			{name: "Koja"},
+			{name: "Kelapa Gading"},
+			{name: "Cilincing"},
+		}},
+		{name: "Kepulauan Seribu", aliases: []string{"thousand islands"}},
+	}},
+	{name: "Jawa Barat", aliases: []string{"jabar", "west java"}, children: []place{
+		{name: "Bandung"},
+		{name: "Cimahi"},
+		{name: "Bekasi"},
+		{name: "Kabupaten Bekasi", children: []place{
+			{name: "Cikarang"},
+		}},
+		{name: "Bogor"},
+		{name: "Depok"},
+		{name: "Cirebon"},
+		{name: "Sukabumi"},
+		{name: "Tasikmalaya"},
+		{name: "Kabupaten Karawang", aliases: []string{"karawang"}},
+		{name: "Kabupaten Purwakarta", aliases: []string{"purwakarta"}},
+	}},
+	{name: "Banten", children: []place{
+		{name: "Tangerang"},
+		{name: "Tangerang Selatan", aliases: []string{"south tangerang", "tangsel"}, children: []place{
+			{name: "Serpong", aliases: []string{"bsd", "bsd city", "bumi serpong damai"}},
+			{name: "Ciputat"},
+			{name: "Pamulang"},
+		}},
+		{name: "Serang"},
+		{name: "Cilegon"},
+	}},
+	{name: "Jawa Tengah", aliases: []string{"jateng", "central java"}, children: []place{
+		{name: "Semarang"},
+		{name: "Surakarta", aliases: []string{"solo"}},
+		{name: "Magelang"},
+		{name: "Pekalongan"},
+		{name: "Tegal"},
+		{name: "Salatiga"},
+		{name: "Kabupaten Kudus", aliases: []string{"kudus"}},
+	}},
+	{name: "DI Yogyakarta", aliases: []string{"yogyakarta", "daerah istimewa yogyakarta", "diy", "jogja", "jogjakarta", "yogya", "jogya"}, children: []place{
+		{name: "Yogyakarta"},
+		{name: "Kabupaten Sleman", aliases: []string{"sleman"}},
+		{name: "Kabupaten Bantul", aliases: []string{"bantul"}},
+	}},
+	{name: "Jawa Timur", aliases: []string{"jatim", "east java"}, children: []place{
+		{name: "Surabaya"},
+		{name: "Malang"},
+		{name: "Kabupaten Sidoarjo", aliases: []string{"sidoarjo"}},
+		{name: "Kabupaten Gresik", aliases: []string{"gresik"}},
+		{name: "Kediri"},
+		{name: "Madiun"},
+		{name: "Kabupaten Jember", aliases: []string{"jember"}},
+		{name: "Mojokerto"},
+		{name: "Pasuruan"},
+		{name: "Probolinggo"},
+		{name: "Kota Batu"},
+	}},
+	{name: "Bali", children: []place{
+		{name: "Denpasar"},
+		{name: "Kabupaten Badung", aliases: []string{"badung", "kuta"}},
+		{name: "Kabupaten Gianyar", aliases: []string{"gianyar", "ubud"}},
+	}},
+	{name: "Nusa Tenggara Barat", aliases: []string{"ntb", "west nusa tenggara"}, children: []place{
+		{name: "Mataram"},
+	}},
+	{name: "Nusa Tenggara Timur", aliases: []string{"ntt", "east nusa tenggara"}, children: []place{
+		{name: "Kupang"},
+	}},
+	{name: "Kalimantan Barat", aliases: []string{"kalbar", "west kalimantan"}, children: []place{
+		{name: "Pontianak"},
+	}},
+	{name: "Kalimantan Tengah", aliases: []string{"kalteng", "central kalimantan"}, children: []place{
+		{name: "Palangka Raya", aliases: []string{"palangkaraya"}},
+	}},
+	{name: "Kalimantan Selatan", aliases: []string{"kalsel", "south kalimantan"}, children: []place{
+		{name: "Banjarmasin"},
+		{name: "Banjarbaru"},
+	}},
+	{name: "Kalimantan Timur", aliases: []string{"kaltim", "east kalimantan"}, children: []place{
+		{name: "Samarinda"},
+		{name: "Balikpapan"},
+		{name: "Bontang"},
+	}},
+	{name: "Kalimantan Utara", aliases: []string{"kaltara", "north kalimantan"}, children: []place{
+		{name: "Tarakan"},
+		{name: "Tanjung Selor"},
+	}},
+	{name: "Sulawesi Utara", aliases: []string{"sulut", "north sulawesi"}, children: []place{
+		{name: "Manado"},
+		{name: "Bitung"},
+	}},
+	{name: "Gorontalo", children: []place{
+		{name: "Gorontalo"},
+	}},
+	{name: "Sulawesi Tengah", aliases: []string{"sulteng", "central sulawesi"}, children: []place{
+		{name: "Palu"},
+	}},
+	{name: "Sulawesi Barat", aliases: []string{"sulbar", "west sulawesi"}, children: []place{
+		{name: "Mamuju"},
+	}},
+	{name: "Sulawesi Selatan", aliases: []string{"sulsel", "south sulawesi"}, children: []place{
+		{name: "Makassar"},
+		{name: "Parepare"},
+	}},
+	{name: "Sulawesi Tenggara", aliases: []string{"sultra", "southeast sulawesi"}, children: []place{
+		{name: "Kendari"},
+	}},
+	{name: "Maluku", children: []place{
+		{name: "Ambon"},
+	}},
+	{name: "Maluku Utara", aliases: []string{"malut", "north maluku"}, children: []place{
+		{name: "Ternate"},
+		{name: "Sofifi"},
+	}},
+	{name: "Papua", children: []place{
+		{name: "Jayapura"},
+	}},
+	{name: "Papua Barat", aliases: []string{"west papua"}, children: []place{
+		{name: "Manokwari"},
+	}},
+	{name: "Papua Barat Daya", aliases: []string{"southwest papua"}, children: []place{
+		{name: "Sorong"},
+	}},
+	{name: "Papua Selatan", aliases: []string{"south papua"}, children: []place{
+		{name: "Merauke"},
+	}},
+	{name: "Papua Tengah", aliases: []string{"central papua"}, children: []place{
+		{name: "Nabire"},
+	}},
+	{name: "Papua Pegunungan", aliases: []string{"highland papua"}, children: []place{
+		{name: "Kabupaten Jayawijaya", aliases: []string{"jayawijaya", "wamena"}},
+	}},
+}
+
+// remotePlace stands outside the hierarchy for jobs without a workplace.
+var remotePlace = place{name: "Remote", aliases: []string{"wfh", "work from home", "work from anywhere", "anywhere", "fully remote", "remote indonesia"}}
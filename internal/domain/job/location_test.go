@@ -0,0 +1,92 @@
+package job
+
+import "testing"
+
+func TestResolveLocation(t *testing.T) {
+	cases := map[string]string{
+		"Jakarta Selatan":                    "dki-jakarta/jakarta-selatan",
+		"South Jakarta":                      "dki-jakarta/jakarta-selatan",
+		"DKI Jakarta":                        "dki-jakarta",
+		"Kota Jakarta Selatan, Jakarta Raya": "dki-jakarta/jakarta-selatan",
+		"Tebet, Jakarta Selatan":             "dki-jakarta/jakarta-selatan/tebet",
+		"Jakarta Selatan, Indonesia":         "dki-jakarta/jakarta-selatan",
+		"Bandung, Jawa Barat":                "jawa-barat/bandung",
+		"Kab. Bekasi":                        "jawa-barat/kabupaten-bekasi",
+		"Cikarang, Bekasi":                   "jawa-barat/kabupaten-bekasi/cikarang",
+		"BSD City, Tangerang":                "banten/tangerang-selatan/serpong",
+		"Jambi":                              "jambi",
+		"Kota Jambi":                         "jambi/jambi",
+		"Jogja":                              "di-yogyakarta",
+		"Remote":                             "remote",
+		"Work From Home":                     "remote",
+		"Hybrid - Surabaya":                  "jawa-timur/surabaya",
+		"Indonesia":                          "",
+		"":                                   "",
+	}
+	for raw, want := range cases {
+		loc, ok := ResolveLocation(raw)
+		if loc.ID != want || ok != (want != "") {
+			t.Errorf("ResolveLocation(%q) = %q, %v; want %q", raw, loc.ID, ok, want)
+		}
+	}
+}
+
+func TestLocationCovers(t *testing.T) {
+	jakarta, ok := LookupLocation("jakarta")
+	if !ok || jakarta.Level != LocationProvince {
+		t.Fatalf("LookupLocation(jakarta) = %+v, %v", jakarta, ok)
+	}
+	kota := 0
+	for _, loc := range locationsByID {
+		if loc.ParentID == jakarta.ID && loc.Name != "Kepulauan Seribu" {
+			kota++
+		}
+	}
+	if kota != 5 {
+		t.Fatalf("DKI Jakarta has %d kota, want 5", kota)
+	}
+	if !jakarta.Covers("dki-jakarta/jakarta-utara/koja") || jakarta.Covers("dki-jakartax") || jakarta.Covers("banten/tangerang") {
+		t.Fatal("Covers does not follow the hierarchy")
+	}
+}
+
+func TestLocationsWithin(t *testing.T) {
+	jakarta, _ := LocationCoordinates("dki-jakarta")
+	within := map[string]float64{}
+	for _, l := range LocationsWithin(jakarta, 30) {
+		within[l.ID] = l.Km
+	}
+	for _, id := range []string{"dki-jakarta", "dki-jakarta/jakarta-selatan", "jawa-barat/bekasi", "jawa-barat/depok", "banten/tangerang", "banten/tangerang-selatan/serpong"} {
+		if _, ok := within[id]; !ok {
+			t.Errorf("%s is not within 30 km of Jakarta", id)
+		}
+	}
+	for _, id := range []string{"jawa-barat/bandung", "jawa-barat", "remote"} {
+		if km, ok := within[id]; ok {
+			t.Errorf("%s is within 30 km of Jakarta (%.1f km)", id, km)
+		}
+	}
+	if d := DistanceKm(jakarta, locationCoordinates["jawa-barat/bandung"]); d < 110 || d > 130 {
+		t.Errorf("Jakarta to Bandung = %.1f km, want about 120", d)
+	}
+
+	// Provinces stand at their capital for display only; a radius search
+	// around the capital must not pull in the whole province.
+	for center, province := range map[string]string{
+		"jawa-barat/bandung":   "jawa-barat",
+		"jawa-timur/surabaya":  "jawa-timur",
+		"jawa-tengah/semarang": "jawa-tengah",
+	} {
+		c, _ := LocationCoordinates(center)
+		ids := map[string]bool{}
+		for _, l := range LocationsWithin(c, 30) {
+			ids[l.ID] = true
+		}
+		if !ids[center] {
+			t.Errorf("%s is not within 30 km of itself", center)
+		}
+		if ids[province] {
+			t.Errorf("%s is within 30 km of %s", province, center)
+		}
+	}
+}
@@ -0,0 +1,230 @@
+package job
+
+import (
+	"math"
+	"regexp"
+	"strconv"
+	"strings"
+)
+
+const (
+	SalaryPeriodHour  = "hour"
+	SalaryPeriodDay   = "day"
+	SalaryPeriodWeek  = "week"
+	SalaryPeriodMonth = "month"
+	SalaryPeriodYear  = "year"
+)
+
+// Salary is a pay range normalised to IDR per month. Currency and Period are
+// what the posting stated.
+type Salary struct {
+	Min      int
+	Max      int
+	Currency string
+	Period   string
+}
+
+// salaryRatesIDR are fixed conversion rates. Salaries are only filtered and
+// sorted in broad bands, so they do not need to track the market.
+var salaryRatesIDR = map[string]float64{
+	"IDR": 1,
+	"USD": 16000,
+	"SGD": 12000,
+	"EUR": 17500,
+}
+
+// Monthly equivalents assume a 40-hour, 22-day working month.
+var salaryPeriodMonths = map[string]float64{
+	SalaryPeriodHour:  173,
+	SalaryPeriodDay:   22,
+	SalaryPeriodWeek:  52.0 / 12,
+	SalaryPeriodMonth: 1,
+	SalaryPeriodYear:  1.0 / 12,
+}
+
+// Parsed salaries outside this monthly IDR range are treated as misreads
+// (a meal allowance, a funding round).
+const (
+	minMonthlySalaryIDR = 500_000
+	maxMonthlySalaryIDR = 1_000_000_000
+)
+
+const (
+	salaryCurrencyPattern = `(rp\.?|idr|us\$|usd|s\$|sgd|eur|€|\$)`
+	salaryAmountPattern   = `(\d[\d.,]*)\s*(k|rb|ribu|jt|juta|mio|million|mn|m)?\b`
+	// salaryIDRAmountPattern is for amounts without a currency, where only the
+	// Indonesian units say the figure is rupiah.
+	salaryIDRAmountPattern = `(\d[\d.,]*)\s*(rb|ribu|jt|juta)?\b`
+	salaryRangePattern     = `\s*(?:-|–|—|~|to|s/d|sd|sampai|hingga)\s*`
+	salaryPeriodPattern    = `(?:\s*(?:/|per)\s*(jam|hour|hr|hari|day|minggu|week|bulan|bln|month|mo|tahun|thn|year|yr|annum)\b)?`
+	salaryKeywordPattern   = `\b(?:gaji|salary|upah|kompensasi|compensation|take home pay|thp|pay)\b`
+)
+
+var (
+	salaryRe = regexp.MustCompile(`(?i)` + salaryCurrencyPattern + `\s*` + salaryAmountPattern +
+		`(?:` + salaryRangePattern + salaryCurrencyPattern + `?\s*` + salaryAmountPattern + `)?` + salaryPeriodPattern)
+	// salaryNoCurrencyRe matches "Gaji 5-7 juta" and "salary up to 15 juta".
+	// The empty groups keep the submatch layout of salaryRe.
+	salaryNoCurrencyRe = regexp.MustCompile(`(?i)` + salaryKeywordPattern +
+		`\s*:?\s*(?:(?:up to|upto|hingga|sampai|s/d|max|maks|maksimal|mulai|from)\s*)?()` + salaryIDRAmountPattern +
+		`(?:` + salaryRangePattern + `()` + salaryIDRAmountPattern + `)?` + salaryPeriodPattern)
+	salaryKeywordRe = regexp.MustCompile(`(?i)` + salaryKeywordPattern)
+)
+
+var salaryCurrencies = map[string]string{
+	"rp": "IDR", "rp.": "IDR", "idr": "IDR",
+	"$": "USD", "us$": "USD", "usd": "USD",
+	"s$": "SGD", "sgd": "SGD",
+	"€": "EUR", "eur": "EUR",
+}
+
+var salaryMultipliers = map[string]float64{
+	"k": 1e3, "rb": 1e3, "ribu": 1e3,
+	"jt": 1e6, "juta": 1e6, "mio": 1e6, "million": 1e6, "mn": 1e6, "m": 1e6,
+}
+
+var salaryPeriods = map[string]string{
+	"jam": SalaryPeriodHour, "hour": SalaryPeriodHour, "hr": SalaryPeriodHour,
+	"hari": SalaryPeriodDay, "day": SalaryPeriodDay,
+	"minggu": SalaryPeriodWeek, "week": SalaryPeriodWeek,
+	"bulan": SalaryPeriodMonth, "bln": SalaryPeriodMonth, "month": SalaryPeriodMonth, "mo": SalaryPeriodMonth,
+	"tahun": SalaryPeriodYear, "thn": SalaryPeriodYear, "year": SalaryPeriodYear, "yr": SalaryPeriodYear, "annum": SalaryPeriodYear,
+}
+
+// ParseSalary finds a salary in posting text ("Rp 8.000.000 - Rp 12.000.000
+// per bulan", "IDR 10jt", "$3k/month"). Amounts need a currency marker, or a
+// word like "gaji" or "salary" right before them and an rb/jt unit ("Gaji 5-7
+// juta"), which makes them rupiah. When several are present, one following
+// such a word wins.
+func ParseSalary(text string) (Salary, bool) {
+	var first Salary
+	found := false
+	for _, m := range salaryRe.FindAllStringSubmatchIndex(text, -1) {
+		s, ok := salaryFromMatch(text, m)
+		if !ok {
+			continue
+		}
+		if salaryKeywordRe.MatchString(text[max(0, m[0]-60):m[0]]) {
+			return s, true
+		}
+		if !found {
+			first, found = s, true
+		}
+	}
+	for _, m := range salaryNoCurrencyRe.FindAllStringSubmatchIndex(text, -1) {
+		// Without a unit a bare number is as likely years or headcount.
+		if m[6] < 0 && m[12] < 0 {
+			continue
+		}
+		if s, ok := salaryFromMatch(text, m); ok {
+			return s, true
+		}
+	}
+	return first, found
+}
+
+// JobSalary reads the salary from a posting, where it is stated either in the
+// title ("Staff Admin (Gaji 5jt)") or the description.
+func JobSalary(title, description string) (Salary, bool) {
+	return ParseSalary(title + "\n" + description)
+}
+
+func salaryFromMatch(text string, m []int) (Salary, bool) {
+	group := func(i int) string {
+		if m[2*i] < 0 {
+			return ""
+		}
+		return strings.ToLower(text[m[2*i]:m[2*i+1]])
+	}
+
+	currency := salaryCurrencies[group(1)]
+	if group(1) == "" {
+		// salaryNoCurrencyRe only matches rupiah units.
+		currency = "IDR"
+	}
+	low, ok := parseSalaryAmount(group(2), group(3))
+	if !ok {
+		return Salary{}, false
+	}
+	high := low
+	if group(5) != "" {
+		unit := group(6)
+		if unit == "" {
+			// "Rp 8 - 12 juta": the unit is only written once.
+			unit = group(3)
+		}
+		if high, ok = parseSalaryAmount(group(5), unit); !ok {
+			return Salary{}, false
+		}
+		if group(3) == "" && group(6) != "" {
+			// "Rp 8 - 12jt" carries the unit on the second amount only.
+			low, _ = parseSalaryAmount(group(2), group(6))
+		}
+	}
+	if high < low {
+		low, high = high, low
+	}
+
+	period := salaryPeriods[group(7)]
+	if period == "" {
+		// Unstated periods are monthly, except for amounts only plausible
+		// as annual pay.
+		period = SalaryPeriodMonth
+		if (currency == "IDR" && high >= 100e6) || (currency != "IDR" && high >= 20e3) {
+			period = SalaryPeriodYear
+		}
+	}
+
+	factor := salaryRatesIDR[currency] * salaryPeriodMonths[period]
+	s := Salary{
+		Min:      int(math.Round(low * factor)),
+		Max:      int(math.Round(high * factor)),
+		Currency: currency,
+		Period:   period,
+	}
+	if s.Min < minMonthlySalaryIDR || s.Max > maxMonthlySalaryIDR {
+		return Salary{}, false
+	}
+	return s, true
+}
+
+// parseSalaryAmount reads "8.000.000", "10,000,000", "3,5" or "2.5" with an
+// optional unit. A single separator followed by three digits is a thousands
+// separator unless a unit follows ("1.500" is 1500, "1.5jt" is 1.5 million).
+func parseSalaryAmount(num, unit string) (float64, bool) {
+	num = strings.TrimRight(num, ".,")
+	if num == "" {
+		return 0, false
+	}
+	dots, commas := strings.Count(num, "."), strings.Count(num, ",")
+	var s string
+	switch {
+	case dots > 0 && commas > 0:
+		// The later separator is the decimal one: "10.000,50", "10,000.50".
+		dec := ","
+		if strings.LastIndex(num, ".") > strings.LastIndex(num, ",") {
+			dec = "."
+		}
+		thousands := map[string]string{",": ".", ".": ","}[dec]
+		s = strings.Replace(strings.ReplaceAll(num, thousands, ""), dec, ".", 1)
+	case dots+commas > 1:
+		s = strings.NewReplacer(".", "", ",", "").Replace(num)
+	case dots+commas == 1:
+		sep := strings.IndexAny(num, ".,")
+		if len(num)-sep-1 == 3 && unit == "" {
+			s = num[:sep] + num[sep+1:]
+		} else {
+			s = num[:sep] + "." + num[sep+1:]
+		}
+	default:
+		s = num
+	}
+	v, err := strconv.ParseFloat(s, 64)
+	if err != nil || v <= 0 {
+		return 0, false
+	}
+	if mult, ok := salaryMultipliers[unit]; ok {
+		v *= mult
+	}
+	return v, true
+}
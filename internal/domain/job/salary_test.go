@@ -0,0 +1,40 @@
+package job
+
+import "testing"
+
+func TestParseSalary(t *testing.T) {
+	cases := []struct {
+		text string
+		want Salary
+		ok   bool
+	}{
+		{"Gaji: Rp 8.000.000 - Rp 12.000.000 per bulan", Salary{8_000_000, 12_000_000, "IDR", SalaryPeriodMonth}, true},
+		{"Salary IDR 10jt", Salary{10_000_000, 10_000_000, "IDR", SalaryPeriodMonth}, true},
+		{"Remote role, $3k/month", Salary{48_000_000, 48_000_000, "USD", SalaryPeriodMonth}, true},
+		{"Rp8jt-12jt/bln", Salary{8_000_000, 12_000_000, "IDR", SalaryPeriodMonth}, true},
+		{"Rp 8 - 12 juta", Salary{8_000_000, 12_000_000, "IDR", SalaryPeriodMonth}, true},
+		{"IDR 10,000,000 - 15,000,000 /month", Salary{10_000_000, 15_000_000, "IDR", SalaryPeriodMonth}, true},
+		{"IDR 1.5M", Salary{1_500_000, 1_500_000, "IDR", SalaryPeriodMonth}, true},
+		{"Rp 2M", Salary{2_000_000, 2_000_000, "IDR", SalaryPeriodMonth}, true},
+		{"Rp 2M - 3mn /month", Salary{2_000_000, 3_000_000, "IDR", SalaryPeriodMonth}, true},
+		{"Rp 4,5 juta", Salary{4_500_000, 4_500_000, "IDR", SalaryPeriodMonth}, true},
+		{"Upah Rp 150.000/hari", Salary{3_300_000, 3_300_000, "IDR", SalaryPeriodDay}, true},
+		{"USD 60,000 - 72,000", Salary{80_000_000, 96_000_000, "USD", SalaryPeriodYear}, true},
+		{"Uang makan Rp 25.000. Gaji Rp 5.000.000", Salary{5_000_000, 5_000_000, "IDR", SalaryPeriodMonth}, true},
+		{"Gaji 5-7 juta", Salary{5_000_000, 7_000_000, "IDR", SalaryPeriodMonth}, true},
+		{"salary up to 15 juta", Salary{15_000_000, 15_000_000, "IDR", SalaryPeriodMonth}, true},
+		{"gaji 10 - 15 jt/bulan", Salary{10_000_000, 15_000_000, "IDR", SalaryPeriodMonth}, true},
+		{"Gaji: 4500 rb", Salary{4_500_000, 4_500_000, "IDR", SalaryPeriodMonth}, true},
+		{"Uang makan Rp 25.000, gaji 6jt", Salary{6_000_000, 6_000_000, "IDR", SalaryPeriodMonth}, true},
+		{"Gaji 2 kali setahun, pengalaman 5 tahun", Salary{}, false},
+		{"Tim 5 - 7 juta pengguna aktif", Salary{}, false},
+		{"Pengalaman 3 tahun, gaji kompetitif", Salary{}, false},
+		{"We raised $5 million", Salary{}, false},
+	}
+	for _, tc := range cases {
+		got, ok := ParseSalary(tc.text)
+		if ok != tc.ok || got != tc.want {
+			t.Errorf("ParseSalary(%q) = %+v, %v; want %+v, %v", tc.text, got, ok, tc.want, tc.ok)
+		}
+	}
+}
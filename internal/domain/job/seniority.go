@@ -0,0 +1,155 @@
+package job
+
+import (
+	"regexp"
+	"strconv"
+	"strings"
+)
+
+const (
+	SeniorityIntern = "intern"
+	SeniorityJunior = "junior"
+	SeniorityMid    = "mid"
+	SenioritySenior = "senior"
+	SeniorityLead   = "lead"
+)
+
+var seniorityRanks = map[string]int{
+	SeniorityIntern: 1,
+	SeniorityJunior: 2,
+	SeniorityMid:    3,
+	SenioritySenior: 4,
+	SeniorityLead:   5,
+}
+
+// Cue lists are matched on whole words of the normalized text, in the order
+// below, so "Senior Tech Lead" resolves to lead and "Senior Intern" to intern.
+// Words that are also job functions stay out: "manager" only counts for
+// engineering management (not "Account Manager"), "entry" and "middle" only as
+// "entry level" and "middle level" (not "Data Entry", "Middle East"), and
+// "expert" and "ahli" not at all ("Expert Advisor", "Ahli Gizi").
+var seniorityCues = []struct {
+	level string
+	cues  []string
+}{
+	{SeniorityIntern, []string{"intern", "internship", "magang", "pemagangan", "trainee", "pkl", "praktik kerja", "praktek kerja", "kerja praktik"}},
+	{SeniorityLead, []string{"lead", "tech lead", "team lead", "principal", "staff engineer", "head of", "architect", "engineering manager", "development manager", "director", "vp", "kepala", "ketua tim", "pimpinan"}},
+	{SenioritySenior, []string{"senior", "sr", "berpengalaman"}},
+	{SeniorityJunior, []string{"junior", "jr", "entry level", "fresh graduate", "freshgraduate", "fresh grad", "fresher", "lulusan baru", "graduate program", "pemula"}},
+	{SeniorityMid, []string{"mid", "mid level", "middle level", "intermediate", "menengah"}},
+}
+
+// Only cues that are unambiguous in free text are trusted from descriptions;
+// words like "senior" often refer to teammates rather than the role.
+var descriptionCues = []struct {
+	level string
+	cues  []string
+}{
+	{SeniorityIntern, []string{"internship", "magang", "program magang"}},
+	{SeniorityJunior, []string{"fresh graduate", "freshgraduate", "lulusan baru", "entry level"}},
+}
+
+var (
+	nonWordRe = regexp.MustCompile(`[^a-z0-9+#]+`)
+	yearsRe   = regexp.MustCompile(`(\d{1,2})\s*\+?\s*(?:-\s*\d{1,2}\s*)?(?:years?|yrs?|tahun|thn)`)
+)
+
+// ClassifySeniority derives a level from a job title and description. It
+// returns "" when there is no usable signal.
+func ClassifySeniority(title, description string) string {
+	t := normalizeSeniorityText(title)
+	for _, group := range seniorityCues {
+		if containsCue(t, group.cues) {
+			return group.level
+		}
+	}
+
+	d := normalizeSeniorityText(description)
+	for _, group := range descriptionCues {
+		if containsCue(d, group.cues) {
+			return group.level
+		}
+	}
+
+	if m := yearsRe.FindStringSubmatch(strings.ToLower(description)); m != nil {
+		if years, err := strconv.Atoi(m[1]); err == nil {
+			return SeniorityFromYears(years)
+		}
+	}
+	return ""
+}
+
+// ParseSeniority normalizes free-form input such as a user profile's
+// experience_level: a level name, a cue ("fresh graduate", "magang") or a
+// number of years.
+func ParseSeniority(raw string) string {
+	s := normalizeSeniorityText(raw)
+	if s == "" {
+		return ""
+	}
+	if _, ok := seniorityRanks[strings.TrimSpace(s)]; ok {
+		return strings.TrimSpace(s)
+	}
+	for _, group := range seniorityCues {
+		if containsCue(s, group.cues) {
+			return group.level
+		}
+	}
+	if years, err := strconv.Atoi(strings.TrimSpace(s)); err == nil {
+		return SeniorityFromYears(years)
+	}
+	if m := yearsRe.FindStringSubmatch(strings.ToLower(raw)); m != nil {
+		if years, err := strconv.Atoi(m[1]); err == nil {
+			return SeniorityFromYears(years)
+		}
+	}
+	return ""
+}
+
+func SeniorityFromYears(years int) string {
+	switch {
+	case years <= 0:
+		return SeniorityIntern
+	case years <= 1:
+		return SeniorityJunior
+	case years <= 4:
+		return SeniorityMid
+	case years <= 7:
+		return SenioritySenior
+	default:
+		return SeniorityLead
+	}
+}
+
+// SeniorityRank orders levels from 1 (intern) to 5 (lead); unknown is 0.
+func SeniorityRank(level string) int {
+	return seniorityRanks[strings.ToLower(strings.TrimSpace(level))]
+}
+
+func IsValidSeniority(level string) bool {
+	_, ok := seniorityRanks[level]
+	return ok
+}
+
+func normalizeSeniorityText(s string) string {
+	s = strings.ToLower(s)
+	s = strings.ReplaceAll(s, "-", " ")
+	s = nonWordRe.ReplaceAllString(s, " ")
+	s = strings.TrimSpace(s)
+	if s == "" {
+		return ""
+	}
+	return " " + s + " "
+}
+
+func containsCue(normalized string, cues []string) bool {
+	if normalized == "" {
+		return false
+	}
+	for _, c := range cues {
+		if strings.Contains(normalized, " "+c+" ") {
+			return true
+		}
+	}
+	return false
+}
@@ -0,0 +1,53 @@
+package job
+
+import "testing"
+
+func TestClassifySeniority(t *testing.T) {
+	cases := []struct {
+		title       string
+		description string
+		want        string
+	}{
+		{"Backend Engineer Intern", "", SeniorityIntern},
+		{"Program Magang Data Analyst", "", SeniorityIntern},
+		{"Senior Tech Lead", "", SeniorityLead},
+		{"Sr. Backend Engineer (Go)", "", SenioritySenior},
+		{"Junior Frontend Developer", "", SeniorityJunior},
+		{"Software Engineer", "Terbuka untuk fresh graduate", SeniorityJunior},
+		{"Software Engineer", "Minimal 5 tahun pengalaman", SenioritySenior},
+		{"Software Engineer", "3+ years of experience with Go", SeniorityMid},
+		{"Leadership Coach", "", ""},
+		{"Data Entry Clerk", "", ""},
+		{"Entry Level Data Entry", "", SeniorityJunior},
+		{"Account Manager", "", ""},
+		{"Sales Manager", "", ""},
+		{"Project Manager", "", ""},
+		{"Engineering Manager", "", SeniorityLead},
+		{"Senior Software Development Manager", "", SeniorityLead},
+		{"Ahli Gizi", "", ""},
+		{"Expert Advisor Trader", "", ""},
+		{"Middle East Sales Executive", "", ""},
+		{"Middle Level Backend Engineer", "", SeniorityMid},
+		{"Software Engineer", "Work with senior engineers", ""},
+	}
+	for _, tc := range cases {
+		if got := ClassifySeniority(tc.title, tc.description); got != tc.want {
+			t.Errorf("ClassifySeniority(%q, %q) = %q, want %q", tc.title, tc.description, got, tc.want)
+		}
+	}
+}
+
+func TestParseSeniority(t *testing.T) {
+	cases := map[string]string{
+		"Senior":         SenioritySenior,
+		"fresh graduate": SeniorityJunior,
+		"3":              SeniorityMid,
+		"10 years":       SeniorityLead,
+		"":               "",
+	}
+	for in, want := range cases {
+		if got := ParseSeniority(in); got != want {
+			t.Errorf("ParseSeniority(%q) = %q, want %q", in, got, want)
+		}
+	}
+}
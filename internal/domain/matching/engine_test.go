@@ -0,0 +1,121 @@
+package matching
+
+import (
+	"testing"
+
+	"github.com/google/uuid"
+)
+
+func TestEngineBuiltinProfilesMatchLegacyScores(t *testing.T) {
+	goID := uuid.New()
+	sqlID := uuid.New()
+	k8sID := uuid.New()
+
+	users := []UserSkill{
+		{SkillID: goID, SkillName: "go", ProficiencyLevel: 3, YearsExperience: 2},
+		{SkillID: sqlID, SkillName: "sql", ProficiencyLevel: 5, YearsExperience: 5},
+	}
+	reqs := []JobRequirement{
+		{SkillID: goID, SkillName: "go", RequiredLevel: 4, IsMandatory: true, RequiredYears: 3},
+		{SkillID: sqlID, SkillName: "sql", RequiredLevel: 3, IsMandatory: false, RequiredYears: 2},
+		{SkillID: k8sID, SkillName: "kubernetes", RequiredLevel: 2, IsMandatory: false, RequiredYears: 1},
+	}
+
+	// 60*3/4 + 30/2 + 10*(2/3+1+0)/3 = 45 + 15 + 5.56
+	res := Calculate(users, reqs)
+	if res.MatchScore != 66 {
+		t.Fatalf("expected legacy score 66, got %d", res.MatchScore)
+	}
+	if res.MandatoryMissing {
+		t.Fatalf("expected mandatory_missing=false")
+	}
+	if len(res.MissingSkills) != 1 || res.MissingSkills[0].SkillID != k8sID {
+		t.Fatalf("expected kubernetes to be missing, got %+v", res.MissingSkills)
+	}
+}
+
+func TestEngineLinearImportanceWeighsOptionalSkills(t *testing.T) {
+	a := uuid.New()
+	b := uuid.New()
+	optional := false
+
+	users := []UserSkillV2{{SkillID: a, ProficiencyLevel: 5, YearsExperience: 5}}
+	reqs := []JobRequirementV2{
+		{SkillID: a, IsMandatory: &optional, ImportanceWeight: 3},
+		{SkillID: b, IsMandatory: &optional, ImportanceWeight: 1},
+	}
+
+	flat := NewEngine(DefaultV2Profile()).Score(users, reqs)
+
+	p := DefaultV2Profile()
+	p.Name = "weighted"
+	p.ImportanceMode = ImportanceLinear
+	weighted := NewEngine(p).Score(users, reqs)
+
+	if weighted.Breakdown.Optional <= flat.Breakdown.Optional {
+		t.Fatalf("expected importance weighting to raise optional points, flat=%.2f weighted=%.2f", flat.Breakdown.Optional, weighted.Breakdown.Optional)
+	}
+	if weighted.ProfileName != "weighted" {
+		t.Fatalf("expected profile name to be reported, got %q", weighted.ProfileName)
+	}
+}
+
+func TestProfileRegistryKeepsHighestVersion(t *testing.T) {
+	r := NewProfileRegistry()
+
+	p := DefaultV2Profile()
+	p.Version = 3
+	p.PartialCurve = CurveQuadratic
+	if err := r.Register(p); err != nil {
+		t.Fatalf("unexpected err: %v", err)
+	}
+	p.Version = 2
+	if err := r.Register(p); err != nil {
+		t.Fatalf("unexpected err: %v", err)
+	}
+
+	if got := r.Active(); got.Version != 3 {
+		t.Fatalf("expected version 3, got %d", got.Version)
+	}
+	if err := r.SetActive("missing"); err == nil {
+		t.Fatalf("expected error for unknown profile")
+	}
+}
+
+func TestEngineGraphCreditsRelatedSkill(t *testing.T) {
+	sqlID := uuid.New()
+	pgID := uuid.New()
+	mysqlID := uuid.New()
+	mandatory := true
+	level := 4
+
+	g := NewSkillGraph([]SkillRelation{
+		{SkillID: sqlID, RelatedSkillID: pgID, RelationType: RelationParent, Credit: 1},
+		{SkillID: pgID, RelatedSkillID: mysqlID, RelationType: RelationSibling, Credit: 0.6},
+	})
+
+	users := []UserSkillV2{{SkillID: pgID, SkillName: "postgresql", ProficiencyLevel: 5, YearsExperience: 6}}
+	reqs := []JobRequirementV2{{SkillID: mysqlID, SkillName: "mysql", RequiredLevel: &level, IsMandatory: &mandatory}}
+
+	without := NewEngine(DefaultV2Profile()).Score(users, reqs)
+	with := Engine{Profile: DefaultV2Profile(), Graph: g}.Score(users, reqs)
+
+	if without.MatchScore != 0 {
+		t.Fatalf("expected no credit without graph, got %d", without.MatchScore)
+	}
+	if with.MatchScore <= without.MatchScore {
+		t.Fatalf("expected graph credit to raise score, got %d", with.MatchScore)
+	}
+	cb := with.MissingSkills[0].CreditedBy
+	if cb == nil || cb.SkillID != pgID || cb.Relation != RelationSibling {
+		t.Fatalf("expected credit from postgresql sibling, got %+v", cb)
+	}
+
+	rel := g.Related(sqlID)
+	if rc, ok := rel[pgID]; !ok || rc.Credit != 1 {
+		t.Fatalf("expected child to give full parent credit, got %+v", rel)
+	}
+	if rc, ok := rel[mysqlID]; !ok || rc.Relation != RelationTransitive {
+		t.Fatalf("expected transitive credit via sibling, got %+v", rel)
+	}
+}
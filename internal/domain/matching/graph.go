@@ -0,0 +1,153 @@
+package matching
+
+import (
+	"github.com/google/uuid"
+)
+
+const (
+	RelationParent  = "parent"
+	RelationSibling = "sibling"
+	RelationImplies = "implies"
+
+	RelationTransitive = "transitive"
+)
+
+const (
+	maxRelationDepth  = 3
+	parentToChildRate = 0.5
+)
+
+// SkillRelation is one stored edge. For "parent" the SkillID is the broader
+// skill (SQL) and RelatedSkillID the narrower one (PostgreSQL); "implies" reads
+// as "knowing SkillID implies RelatedSkillID"; "sibling" works both ways.
+type SkillRelation struct {
+	SkillID        uuid.UUID
+	RelatedSkillID uuid.UUID
+	RelationType   string
+	Credit         float64
+}
+
+func IsValidRelationType(t string) bool {
+	switch t {
+	case RelationParent, RelationSibling, RelationImplies:
+		return true
+	default:
+		return false
+	}
+}
+
+type RelatedCredit struct {
+	Credit   float64
+	Relation string
+	Depth    int
+}
+
+// SkillGraph holds the precomputed transitive closure of the relation edges,
+// keyed by the required skill and then by the skill the user actually has.
+type SkillGraph struct {
+	closure map[uuid.UUID]map[uuid.UUID]RelatedCredit
+	credits map[uuid.UUID][]uuid.UUID
+}
+
+type creditEdge struct {
+	to       uuid.UUID
+	credit   float64
+	relation string
+}
+
+func NewSkillGraph(relations []SkillRelation) *SkillGraph {
+	// edges[have] lists the requirements that owning "have" gives credit for.
+	edges := make(map[uuid.UUID][]creditEdge)
+	add := func(have, required uuid.UUID, credit float64, relation string) {
+		if have == uuid.Nil || required == uuid.Nil || have == required || credit <= 0 {
+			return
+		}
+		if credit > 1 {
+			credit = 1
+		}
+		edges[have] = append(edges[have], creditEdge{to: required, credit: credit, relation: relation})
+	}
+
+	for _, r := range relations {
+		switch r.RelationType {
+		case RelationParent:
+			add(r.RelatedSkillID, r.SkillID, r.Credit, RelationParent)
+			add(r.SkillID, r.RelatedSkillID, r.Credit*parentToChildRate, RelationParent)
+		case RelationSibling:
+			add(r.SkillID, r.RelatedSkillID, r.Credit, RelationSibling)
+			add(r.RelatedSkillID, r.SkillID, r.Credit, RelationSibling)
+		case RelationImplies:
+			add(r.SkillID, r.RelatedSkillID, r.Credit, RelationImplies)
+		}
+	}
+
+	g := &SkillGraph{
+		closure: make(map[uuid.UUID]map[uuid.UUID]RelatedCredit),
+		credits: make(map[uuid.UUID][]uuid.UUID),
+	}
+	for have := range edges {
+		best := map[uuid.UUID]RelatedCredit{}
+		type node struct {
+			id     uuid.UUID
+			credit float64
+			depth  int
+		}
+		queue := []node{{id: have, credit: 1}}
+		for len(queue) > 0 {
+			cur := queue[0]
+			queue = queue[1:]
+			if cur.depth >= maxRelationDepth {
+				continue
+			}
+			for _, e := range edges[cur.id] {
+				if e.to == have {
+					continue
+				}
+				credit := cur.credit * e.credit
+				relation := e.relation
+				if cur.depth > 0 {
+					relation = RelationTransitive
+				}
+				if prev, ok := best[e.to]; ok && prev.Credit >= credit {
+					continue
+				}
+				best[e.to] = RelatedCredit{Credit: credit, Relation: relation, Depth: cur.depth + 1}
+				queue = append(queue, node{id: e.to, credit: credit, depth: cur.depth + 1})
+			}
+		}
+
+		for required, rc := range best {
+			m, ok := g.closure[required]
+			if !ok {
+				m = make(map[uuid.UUID]RelatedCredit)
+				g.closure[required] = m
+			}
+			m[have] = rc
+			g.credits[have] = append(g.credits[have], required)
+		}
+	}
+	return g
+}
+
+// Related returns the skills that give credit toward the required skill.
+func (g *SkillGraph) Related(required uuid.UUID) map[uuid.UUID]RelatedCredit {
+	if g == nil {
+		return nil
+	}
+	return g.closure[required]
+}
+
+// CreditedSkills returns the skills that owning have gives credit toward.
+func (g *SkillGraph) CreditedSkills(have uuid.UUID) []uuid.UUID {
+	if g == nil {
+		return nil
+	}
+	return g.credits[have]
+}
+
+func (g *SkillGraph) Size() int {
+	if g == nil {
+		return 0
+	}
+	return len(g.closure)
+}
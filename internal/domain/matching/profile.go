@@ -0,0 +1,222 @@
+package matching
+
+import (
+	"errors"
+	"fmt"
+	"strings"
+	"sync"
+)
+
+const (
+	ProfileLegacyV1  = "legacy_v1"
+	ProfileDefaultV2 = "default_v2"
+)
+
+const (
+	ImportanceFlat   = "flat"
+	ImportanceLinear = "linear"
+)
+
+const (
+	CurveLinear    = "linear"
+	CurveQuadratic = "quadratic"
+	CurveSqrt      = "sqrt"
+	CurveNone      = "none"
+)
+
+var ErrInvalidProfile = errors.New("invalid scoring profile")
+
+// Profile describes how the engine turns a skill comparison into points.
+// The three component weights are rescaled to sum to 100 before scoring.
+type Profile struct {
+	Name    string
+	Version int
+
+	MandatoryWeight  float64
+	OptionalWeight   float64
+	ExperienceWeight float64
+
+	ImportanceMode string
+	PartialCurve   string
+	YearsCap       float64
+
+	// RelatedCredit scales credit earned through the skill graph; 0 disables it.
+	RelatedCredit float64
+
+	// SeniorityPenalty is subtracted per level of distance between the user's
+	// experience level and the job's seniority; 0 disables it.
+	SeniorityPenalty float64
+}
+
+func LegacyV1Profile() Profile {
+	return Profile{
+		Name:             ProfileLegacyV1,
+		Version:          1,
+		MandatoryWeight:  60,
+		OptionalWeight:   30,
+		ExperienceWeight: 10,
+		ImportanceMode:   ImportanceFlat,
+		PartialCurve:     CurveLinear,
+		YearsCap:         1,
+	}
+}
+
+func DefaultV2Profile() Profile {
+	return Profile{
+		Name:             ProfileDefaultV2,
+		Version:          3,
+		MandatoryWeight:  60,
+		OptionalWeight:   30,
+		ExperienceWeight: 10,
+		ImportanceMode:   ImportanceFlat,
+		PartialCurve:     CurveLinear,
+		YearsCap:         1,
+		RelatedCredit:    1,
+		SeniorityPenalty: 5,
+	}
+}
+
+func BuiltinProfiles() []Profile {
+	return []Profile{LegacyV1Profile(), DefaultV2Profile()}
+}
+
+func (p Profile) Validate() error {
+	if strings.TrimSpace(p.Name) == "" {
+		return fmt.Errorf("%w: empty name", ErrInvalidProfile)
+	}
+	if p.Version < 1 {
+		return fmt.Errorf("%w: version must be >= 1", ErrInvalidProfile)
+	}
+	if p.MandatoryWeight < 0 || p.OptionalWeight < 0 || p.ExperienceWeight < 0 {
+		return fmt.Errorf("%w: negative component weight", ErrInvalidProfile)
+	}
+	if p.MandatoryWeight+p.OptionalWeight+p.ExperienceWeight <= 0 {
+		return fmt.Errorf("%w: component weights sum to zero", ErrInvalidProfile)
+	}
+	switch p.ImportanceMode {
+	case "", ImportanceFlat, ImportanceLinear:
+	default:
+		return fmt.Errorf("%w: unknown importance mode %q", ErrInvalidProfile, p.ImportanceMode)
+	}
+	switch p.PartialCurve {
+	case "", CurveLinear, CurveQuadratic, CurveSqrt, CurveNone:
+	default:
+		return fmt.Errorf("%w: unknown partial credit curve %q", ErrInvalidProfile, p.PartialCurve)
+	}
+	if p.YearsCap < 0 {
+		return fmt.Errorf("%w: negative years cap", ErrInvalidProfile)
+	}
+	if p.RelatedCredit < 0 || p.RelatedCredit > 1 {
+		return fmt.Errorf("%w: related credit must be within 0..1", ErrInvalidProfile)
+	}
+	if p.SeniorityPenalty < 0 || p.SeniorityPenalty > 25 {
+		return fmt.Errorf("%w: seniority penalty must be within 0..25", ErrInvalidProfile)
+	}
+	return nil
+}
+
+func (p Profile) normalized() Profile {
+	sum := p.MandatoryWeight + p.OptionalWeight + p.ExperienceWeight
+	if sum <= 0 {
+		d := DefaultV2Profile()
+		d.Name = p.Name
+		d.Version = p.Version
+		return d
+	}
+	scale := 100.0 / sum
+	p.MandatoryWeight *= scale
+	p.OptionalWeight *= scale
+	p.ExperienceWeight *= scale
+	if p.ImportanceMode == "" {
+		p.ImportanceMode = ImportanceFlat
+	}
+	if p.PartialCurve == "" {
+		p.PartialCurve = CurveLinear
+	}
+	if p.YearsCap <= 0 {
+		p.YearsCap = 1
+	}
+	return p
+}
+
+type ProfileRegistry struct {
+	mu     sync.RWMutex
+	byName map[string]Profile
+	active string
+}
+
+func NewProfileRegistry() *ProfileRegistry {
+	r := &ProfileRegistry{byName: make(map[string]Profile), active: ProfileDefaultV2}
+	for _, p := range BuiltinProfiles() {
+		r.byName[p.Name] = p
+	}
+	return r
+}
+
+// Register adds a profile, keeping only the highest version per name.
+func (r *ProfileRegistry) Register(p Profile) error {
+	if r == nil {
+		return nil
+	}
+	p.Name = strings.TrimSpace(p.Name)
+	if err := p.Validate(); err != nil {
+		return err
+	}
+
+	r.mu.Lock()
+	defer r.mu.Unlock()
+	if cur, ok := r.byName[p.Name]; ok && cur.Version > p.Version {
+		return nil
+	}
+	r.byName[p.Name] = p
+	return nil
+}
+
+func (r *ProfileRegistry) Get(name string) (Profile, bool) {
+	if r == nil {
+		return Profile{}, false
+	}
+	r.mu.RLock()
+	defer r.mu.RUnlock()
+	p, ok := r.byName[strings.TrimSpace(name)]
+	return p, ok
+}
+
+func (r *ProfileRegistry) SetActive(name string) error {
+	if r == nil {
+		return nil
+	}
+	name = strings.TrimSpace(name)
+	r.mu.Lock()
+	defer r.mu.Unlock()
+	if _, ok := r.byName[name]; !ok {
+		return fmt.Errorf("%w: unknown profile %q", ErrInvalidProfile, name)
+	}
+	r.active = name
+	return nil
+}
+
+func (r *ProfileRegistry) Active() Profile {
+	if r == nil {
+		return DefaultV2Profile()
+	}
+	r.mu.RLock()
+	defer r.mu.RUnlock()
+	if p, ok := r.byName[r.active]; ok {
+		return p
+	}
+	return DefaultV2Profile()
+}
+
+func (r *ProfileRegistry) List() []Profile {
+	if r == nil {
+		return BuiltinProfiles()
+	}
+	r.mu.RLock()
+	defer r.mu.RUnlock()
+	out := make([]Profile, 0, len(r.byName))
+	for _, p := range r.byName {
+		out = append(out, p)
+	}
+	return out
+}
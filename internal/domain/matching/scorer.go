@@ -0,0 +1,233 @@
+package matching
+
+import (
+	"math"
+
+	"github.com/google/uuid"
+)
+
+type ScoreBreakdown struct {
+	Mandatory  float64
+	Optional   float64
+	Experience float64
+	// Seniority is zero or negative: the penalty for a level mismatch.
+	Seniority float64
+}
+
+func (b ScoreBreakdown) Total() float64 {
+	return b.Mandatory + b.Optional + b.Experience + b.Seniority
+}
+
+// overqualifiedRate softens the penalty when the user is above the job's level.
+const overqualifiedRate = 0.5
+
+type Engine struct {
+	Profile Profile
+	Graph   *SkillGraph
+}
+
+func NewEngine(p Profile) Engine {
+	return Engine{Profile: p}
+}
+
+func (e Engine) Score(userSkills []UserSkillV2, reqs []JobRequirementV2) ResultV2 {
+	return e.ScoreWithSeniority(userSkills, reqs, 0, 0)
+}
+
+// ScoreWithSeniority scores like Score and additionally applies the profile's
+// seniority penalty. Ranks run from 1 (intern) to 5 (lead); 0 means unknown
+// and disables the penalty.
+func (e Engine) ScoreWithSeniority(userSkills []UserSkillV2, reqs []JobRequirementV2, userRank, jobRank int) ResultV2 {
+	p := e.Profile.normalized()
+
+	userBySkillID := make(map[uuid.UUID]UserSkillV2, len(userSkills))
+	for _, us := range userSkills {
+		if us.SkillID == uuid.Nil {
+			continue
+		}
+		userBySkillID[us.SkillID] = us
+	}
+
+	mandatory := make([]JobRequirementV2, 0)
+	optional := make([]JobRequirementV2, 0)
+	for _, r := range reqs {
+		if r.SkillID == uuid.Nil {
+			continue
+		}
+		if resolveIsMandatoryV2(r) {
+			mandatory = append(mandatory, r)
+		} else {
+			optional = append(optional, r)
+		}
+	}
+
+	var breakdown ScoreBreakdown
+	matched := make([]MatchedSkillV2, 0, len(reqs))
+	missing := make([]MissingSkillV2, 0)
+
+	expDenom := 0
+	expSum := 0.0
+	mandatoryMissing := false
+
+	score := func(group []JobRequirementV2, componentWeight float64, isMandatory bool) float64 {
+		totalWeight := 0.0
+		for _, r := range group {
+			totalWeight += p.importanceWeight(r)
+		}
+
+		var total float64
+		for _, r := range group {
+			expDenom++
+
+			per := 0.0
+			if totalWeight > 0 {
+				per = componentWeight * p.importanceWeight(r) / totalWeight
+			}
+
+			us, ok := userBySkillID[r.SkillID]
+			if !ok {
+				if isMandatory {
+					mandatoryMissing = true
+				}
+				ms := MissingSkillV2{SkillID: r.SkillID, SkillName: r.SkillName, IsMandatory: isMandatory}
+				if credit, related, ok := e.relatedCredit(p, r, userBySkillID); ok {
+					contrib := per * credit
+					total += contrib
+					ms.CreditedBy = &SkillCredit{
+						SkillID:           related.us.SkillID,
+						SkillName:         related.us.SkillName,
+						Relation:          related.rc.Relation,
+						Credit:            credit,
+						ScoreContribution: int(math.Round(contrib)),
+					}
+					expSum += related.rc.Credit * p.RelatedCredit * p.yearsRatio(related.us.YearsExperience, resolveRequiredYearsV2(r))
+				}
+				missing = append(missing, ms)
+				continue
+			}
+
+			contrib := per * p.partialCredit(us.ProficiencyLevel, resolveRequiredLevelV2(r))
+			total += contrib
+			matched = append(matched, MatchedSkillV2{SkillID: r.SkillID, SkillName: r.SkillName, ScoreContribution: int(math.Round(contrib))})
+
+			expSum += p.yearsRatio(us.YearsExperience, resolveRequiredYearsV2(r))
+		}
+		return total
+	}
+
+	breakdown.Mandatory = score(mandatory, p.MandatoryWeight, true)
+	breakdown.Optional = score(optional, p.OptionalWeight, false)
+	if expDenom > 0 {
+		breakdown.Experience = p.ExperienceWeight * (expSum / float64(expDenom))
+	}
+
+	seniorityGap := 0
+	if userRank > 0 && jobRank > 0 {
+		seniorityGap = jobRank - userRank
+		breakdown.Seniority = -p.seniorityPenalty(seniorityGap)
+	}
+
+	total := int(math.Round(breakdown.Total()))
+	if total < 0 {
+		total = 0
+	}
+	if total > 100 {
+		total = 100
+	}
+
+	return ResultV2{
+		MatchScore:       total,
+		MandatoryMissing: mandatoryMissing,
+		MatchedSkills:    matched,
+		MissingSkills:    missing,
+		Breakdown:        breakdown,
+		ProfileName:      e.Profile.Name,
+		ProfileVersion:   e.Profile.Version,
+		SeniorityGap:     seniorityGap,
+	}
+}
+
+type relatedMatch struct {
+	us UserSkillV2
+	rc RelatedCredit
+}
+
+// relatedCredit picks the user skill that earns the most credit toward a
+// requirement the user does not have, scaled by the profile's related credit.
+func (e Engine) relatedCredit(p Profile, r JobRequirementV2, userBySkillID map[uuid.UUID]UserSkillV2) (float64, relatedMatch, bool) {
+	if e.Graph == nil || p.RelatedCredit <= 0 {
+		return 0, relatedMatch{}, false
+	}
+
+	reqLvl := resolveRequiredLevelV2(r)
+	best := 0.0
+	var out relatedMatch
+	for skillID, rc := range e.Graph.Related(r.SkillID) {
+		us, ok := userBySkillID[skillID]
+		if !ok {
+			continue
+		}
+		credit := rc.Credit * p.RelatedCredit * p.partialCredit(us.ProficiencyLevel, reqLvl)
+		if credit > best || (credit == best && credit > 0 && us.SkillID.String() < out.us.SkillID.String()) {
+			best = credit
+			out = relatedMatch{us: us, rc: rc}
+		}
+	}
+	if best <= 0 {
+		return 0, relatedMatch{}, false
+	}
+	return best, out, true
+}
+
+func (p Profile) seniorityPenalty(gap int) float64 {
+	if gap == 0 || p.SeniorityPenalty <= 0 {
+		return 0
+	}
+	if gap < 0 {
+		return p.SeniorityPenalty * float64(-gap) * overqualifiedRate
+	}
+	return p.SeniorityPenalty * float64(gap)
+}
+
+func (p Profile) importanceWeight(r JobRequirementV2) float64 {
+	if p.ImportanceMode == ImportanceLinear {
+		return float64(clampInt(r.ImportanceWeight, 1, 5))
+	}
+	return 1
+}
+
+func (p Profile) partialCredit(userLevel, requiredLevel int) float64 {
+	usrLvl := clampInt(userLevel, 0, 5)
+	if usrLvl <= 0 {
+		return 0
+	}
+	if requiredLevel <= 0 || usrLvl >= requiredLevel {
+		return 1
+	}
+
+	ratio := float64(usrLvl) / float64(requiredLevel)
+	switch p.PartialCurve {
+	case CurveQuadratic:
+		return ratio * ratio
+	case CurveSqrt:
+		return math.Sqrt(ratio)
+	case CurveNone:
+		return 0
+	default:
+		return ratio
+	}
+}
+
+func (p Profile) yearsRatio(userYears, requiredYears int) float64 {
+	if requiredYears <= 0 {
+		return 1
+	}
+	if userYears <= 0 {
+		return 0
+	}
+	ratio := float64(userYears) / float64(requiredYears)
+	if ratio > p.YearsCap {
+		return p.YearsCap
+	}
+	return ratio
+}
@@ -178,6 +178,55 @@ func (r *Redis) InvalidateCacheByKeyword(ctx context.Context, keyword string) er
 	return firstErr
 }
 
+const searchTermIndexPrefix = "jobs:search:term:"
+
+// IndexSearchKey records a cached search under the query terms it depends on,
+// so InvalidateSearchTerms can drop it when one of those terms changes.
+func (r *Redis) IndexSearchKey(ctx context.Context, key string, terms []string, ttl time.Duration) error {
+	if r.isUnavailable() || strings.TrimSpace(key) == "" || len(terms) == 0 {
+		return nil
+	}
+	if ttl <= 0 {
+		ttl = DefaultTTLFromEnv()
+	}
+	pipe := r.client.Pipeline()
+	for _, t := range terms {
+		idx := searchTermIndexPrefix + t
+		pipe.SAdd(ctx, idx, key)
+		pipe.Expire(ctx, idx, ttl)
+	}
+	if _, err := pipe.Exec(ctx); err != nil {
+		r.warnUnavailableOnce(err)
+		return err
+	}
+	return nil
+}
+
+func (r *Redis) InvalidateSearchTerms(ctx context.Context, terms []string) error {
+	if r.isUnavailable() {
+		return nil
+	}
+	var firstErr error
+	for _, t := range terms {
+		idx := searchTermIndexPrefix + t
+		keys, err := r.client.SMembers(ctx, idx).Result()
+		if err != nil {
+			if firstErr == nil {
+				firstErr = err
+			}
+			continue
+		}
+		keys = append(keys, idx)
+		if err := r.client.Del(ctx, keys...).Err(); err != nil && firstErr == nil {
+			firstErr = err
+		}
+	}
+	if firstErr != nil {
+		r.warnUnavailableOnce(firstErr)
+	}
+	return firstErr
+}
+
 func (r *Redis) SetIfNotExists(ctx context.Context, key string, value string, ttl time.Duration) (bool, error) {
 	if r.isUnavailable() {
 		return false, nil
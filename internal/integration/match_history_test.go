@@ -0,0 +1,96 @@
+package integration
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"skill-sync/internal/database"
+	"skill-sync/internal/repository"
+
+	"github.com/google/uuid"
+)
+
+func TestIntegration_MatchHistory_And_Snapshots(t *testing.T) {
+	ctx, cancel := context.WithTimeout(context.Background(), 60*time.Second)
+	defer cancel()
+
+	db := connectTestDB(t, ctx)
+	defer func() { _ = db.Close() }()
+
+	runMigrations(t, ctx, db)
+
+	seed := seedDummyData(t, ctx, db)
+	defer cleanupSeed(t, ctx, db, seed)
+	clearMatchHistory(ctx, db, seed)
+	defer clearMatchHistory(ctx, db, seed)
+
+	repo := repository.NewPostgresJobMatchRepository(db)
+	pair := func(jobID uuid.UUID, score float64, c repository.MatchComponents, profile string) repository.JobMatchUpsert {
+		return repository.JobMatchUpsert{UserID: seed.userID, JobID: jobID, Score: score, Components: c, ScoringProfile: profile, ScoringVersion: 3}
+	}
+	base := repository.MatchComponents{Mandatory: 30, Optional: 10}
+	write := func(items ...repository.JobMatchUpsert) {
+		t.Helper()
+		if _, err := repo.UpsertBatch(ctx, items); err != nil {
+			t.Fatalf("upsert batch: %v", err)
+		}
+	}
+	historyRows := func() int {
+		t.Helper()
+		var n int
+		if err := db.QueryRow(ctx, `SELECT COUNT(*) FROM job_match_history WHERE user_id = $1`, seed.userID).Scan(&n); err != nil {
+			t.Fatalf("count history: %v", err)
+		}
+		return n
+	}
+
+	write(pair(seed.jobV2ID, 40, base, "default_v2"), pair(seed.jobFallbackID, 80, base, "default_v2"))
+	if n := historyRows(); n != 2 {
+		t.Fatalf("history after first run = %d, want 2", n)
+	}
+
+	write(pair(seed.jobV2ID, 40, base, "default_v2"), pair(seed.jobFallbackID, 80, base, "default_v2"))
+	if n := historyRows(); n != 2 {
+		t.Fatalf("history after an unchanged rerun = %d, want 2", n)
+	}
+
+	// Same total, different breakdown or profile: still a change.
+	write(pair(seed.jobV2ID, 40, repository.MatchComponents{Mandatory: 25, Optional: 15}, "default_v2"))
+	write(pair(seed.jobFallbackID, 80, base, "custom"))
+	if n := historyRows(); n != 4 {
+		t.Fatalf("history after breakdown and profile changes = %d, want 4", n)
+	}
+
+	snapshot := func(topN int) (int64, float64) {
+		t.Helper()
+		n, err := repo.SnapshotUsers(ctx, []uuid.UUID{seed.userID}, topN)
+		if err != nil {
+			t.Fatalf("snapshot: %v", err)
+		}
+		var avg float64
+		if err := db.QueryRow(ctx,
+			`SELECT top_avg::float8 FROM user_match_snapshots WHERE user_id = $1 ORDER BY recorded_at DESC, id DESC LIMIT 1`,
+			seed.userID,
+		).Scan(&avg); err != nil {
+			t.Fatalf("read snapshot: %v", err)
+		}
+		return n, avg
+	}
+
+	if n, avg := snapshot(1); n != 1 || avg != 80 {
+		t.Fatalf("top-1 snapshot = %d rows, avg %.2f; want 1, 80", n, avg)
+	}
+	if n, _ := snapshot(1); n != 0 {
+		t.Fatalf("unchanged snapshot wrote %d rows, want 0", n)
+	}
+	if n, avg := snapshot(2); n != 1 || avg != 60 {
+		t.Fatalf("top-2 snapshot = %d rows, avg %.2f; want 1, 60", n, avg)
+	}
+}
+
+func clearMatchHistory(ctx context.Context, db database.DB, seed seededIDs) {
+	_, _ = db.Exec(ctx, `DELETE FROM job_matches WHERE user_id = $1`, seed.userID)
+	_, _ = db.Exec(ctx, `DELETE FROM job_match_history WHERE user_id = $1`, seed.userID)
+	_, _ = db.Exec(ctx, `DELETE FROM user_match_snapshots WHERE user_id = $1`, seed.userID)
+}
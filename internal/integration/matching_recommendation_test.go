@@ -77,6 +77,9 @@ func TestIntegration_Login_MatchingV2_Recommendations(t *testing.T) {
 		repository.NewPostgresJobRepository(db),
 		repository.NewPostgresJobSkillV2Repository(db),
 		repository.NewPostgresUserSkillRepository(db),
+		nil,
+		nil,
+		nil,
 	)
 
 	resV2, err := matchingV2UC.CalculateMatchV2(ctx, seed.userID, seed.jobV2ID)
@@ -0,0 +1,337 @@
+package pipeline
+
+import (
+	"context"
+	"fmt"
+	"runtime"
+	"sync"
+	"sync/atomic"
+	"time"
+
+	"skill-sync/internal/domain/job"
+	"skill-sync/internal/domain/matching"
+	"skill-sync/internal/repository"
+
+	"github.com/google/uuid"
+)
+
+const (
+	defaultMatchingBatchSize = 1000
+	matchingProgressInterval = 10 * time.Second
+	snapshotChunkSize        = 1000
+)
+
+// matchSnapshotTopN is how many of a user's best matches are averaged into a
+// progress snapshot.
+const matchSnapshotTopN = 10
+
+type batchJob struct {
+	id   uuid.UUID
+	reqs []matching.JobRequirementV2
+	rank int
+}
+
+type batchUnit struct {
+	user uuid.UUID
+	rank int
+	jobs []batchJob
+}
+
+// runMatchingBatch loads every user skill vector and active job requirement
+// once, scores the affected part of the matrix in memory and writes results in
+// bulk. Unless a full rebuild is requested only dirty users (against every job)
+// and dirty jobs (against every user) are rescored.
+func (p *FullPipeline) runMatchingBatch(ctx context.Context, engine matching.Engine, params FullPipelineParams, dirtyCutoff time.Time) error {
+	loadStart := time.Now()
+
+	full := params.MatchingFullRebuild || p.dirty == nil
+	dirtyUsers := map[uuid.UUID]struct{}{}
+	dirtyJobs := map[uuid.UUID]struct{}{}
+	if !full {
+		ids, err := p.dirty.ListDirtyUsers(ctx)
+		if err != nil {
+			return err
+		}
+		for _, id := range ids {
+			dirtyUsers[id] = struct{}{}
+		}
+		ids, err = p.dirty.ListDirtyJobs(ctx)
+		if err != nil {
+			return err
+		}
+		for _, id := range ids {
+			dirtyJobs[id] = struct{}{}
+		}
+		if len(dirtyUsers) == 0 && len(dirtyJobs) == 0 {
+			p.log.Printf("pipeline=full step=matching_v2 mode=incremental status=skipped reason=no_changes")
+			return nil
+		}
+	}
+
+	userSkills, err := p.users.ListAllUserSkills(ctx)
+	if err != nil {
+		return err
+	}
+	jobReqs, err := p.jobsQry.ListActiveJobRequirementsV2(ctx)
+	if err != nil {
+		return err
+	}
+
+	users := make([]uuid.UUID, 0, len(userSkills))
+	vectors := make(map[uuid.UUID][]matching.UserSkillV2, len(userSkills))
+	for uid, items := range userSkills {
+		if len(items) == 0 {
+			continue
+		}
+		vec := make([]matching.UserSkillV2, 0, len(items))
+		for _, it := range items {
+			vec = append(vec, matching.UserSkillV2{
+				SkillID:          it.SkillID,
+				SkillName:        it.SkillName,
+				ProficiencyLevel: it.ProficiencyLevel,
+				YearsExperience:  it.YearsExperience,
+			})
+		}
+		users = append(users, uid)
+		vectors[uid] = vec
+	}
+
+	userRanks, jobRanks := p.loadSeniorityRanks(ctx)
+
+	jobs := make([]batchJob, 0, len(jobReqs))
+	for jid, items := range jobReqs {
+		reqs := make([]matching.JobRequirementV2, 0, len(items))
+		for _, r := range items {
+			reqs = append(reqs, matching.JobRequirementV2{
+				SkillID:          r.SkillID,
+				SkillName:        r.SkillName,
+				RequiredLevel:    r.RequiredLevel,
+				IsMandatory:      r.IsMandatory,
+				RequiredYears:    r.RequiredYears,
+				ImportanceWeight: r.ImportanceWeight,
+			})
+		}
+		jobs = append(jobs, batchJob{id: jid, reqs: reqs, rank: jobRanks[jid]})
+	}
+
+	mode := "full"
+	units := matchingUnits(users, jobs, userRanks, full, dirtyUsers, dirtyJobs)
+	if !full {
+		mode = "incremental"
+
+		// Dirty users left without any skills, and dirty jobs left without any
+		// requirements, keep no matches at all.
+		if emptied := missingFrom(dirtyUsers, vectors); len(emptied) > 0 {
+			if _, err := p.matches.DeleteByUsers(ctx, emptied); err != nil {
+				p.log.Printf("pipeline=full step=matching_v2 mode=incremental status=error op=drop_emptied err=%v", err)
+			}
+		}
+		if emptied := missingFrom(dirtyJobs, jobReqs); len(emptied) > 0 {
+			if _, err := p.matches.DeleteByJobs(ctx, emptied); err != nil {
+				p.log.Printf("pipeline=full step=matching_v2 mode=incremental status=error op=drop_emptied_jobs err=%v", err)
+			}
+		}
+	}
+
+	total := 0
+	for _, u := range units {
+		total += len(u.jobs)
+	}
+	workers := runtime.GOMAXPROCS(0)
+	batchSize := params.MatchingBatchSize
+	if batchSize <= 0 {
+		batchSize = defaultMatchingBatchSize
+	}
+	p.log.Printf("pipeline=full step=matching_v2 mode=%s status=info users=%d jobs=%d dirty_users=%d dirty_jobs=%d total_pairs=%d workers=%d batch_size=%d profile=%s version=%d load_duration=%s",
+		mode, len(units), len(jobs), len(dirtyUsers), len(dirtyJobs), total, workers, batchSize, engine.Profile.Name, engine.Profile.Version, time.Since(loadStart))
+
+	if total > 0 {
+		if err := p.scoreAndWrite(ctx, engine, units, vectors, int64(total), workers, batchSize); err != nil {
+			return err
+		}
+		scoredUsers := make([]uuid.UUID, 0, len(units))
+		for _, u := range units {
+			scoredUsers = append(scoredUsers, u.user)
+		}
+		p.snapshotUsers(ctx, scoredUsers)
+	}
+	p.clearDirty(ctx, dirtyCutoff)
+	return nil
+}
+
+// matchingUnits picks the pairs to score: every job for every user on a full
+// rebuild, otherwise every job for dirty users and the dirty jobs for
+// everyone else.
+func matchingUnits(users []uuid.UUID, jobs []batchJob, userRanks map[uuid.UUID]int, full bool, dirtyUsers, dirtyJobs map[uuid.UUID]struct{}) []batchUnit {
+	units := make([]batchUnit, 0, len(users))
+	if full {
+		for _, uid := range users {
+			units = append(units, batchUnit{user: uid, rank: userRanks[uid], jobs: jobs})
+		}
+		return units
+	}
+
+	changedJobs := make([]batchJob, 0, len(dirtyJobs))
+	for _, j := range jobs {
+		if _, ok := dirtyJobs[j.id]; ok {
+			changedJobs = append(changedJobs, j)
+		}
+	}
+	for _, uid := range users {
+		if _, ok := dirtyUsers[uid]; ok {
+			units = append(units, batchUnit{user: uid, rank: userRanks[uid], jobs: jobs})
+		} else if len(changedJobs) > 0 {
+			units = append(units, batchUnit{user: uid, rank: userRanks[uid], jobs: changedJobs})
+		}
+	}
+	return units
+}
+
+// missingFrom lists the dirty IDs that have no entry in loaded.
+func missingFrom[V any](dirty map[uuid.UUID]struct{}, loaded map[uuid.UUID]V) []uuid.UUID {
+	out := make([]uuid.UUID, 0)
+	for id := range dirty {
+		if _, ok := loaded[id]; !ok {
+			out = append(out, id)
+		}
+	}
+	return out
+}
+
+// loadSeniorityRanks is best effort; on failure matching runs without the
+// seniority factor rather than failing the step.
+func (p *FullPipeline) loadSeniorityRanks(ctx context.Context) (map[uuid.UUID]int, map[uuid.UUID]int) {
+	userRanks := map[uuid.UUID]int{}
+	jobRanks := map[uuid.UUID]int{}
+	if p.seniority == nil {
+		return userRanks, jobRanks
+	}
+
+	levels, err := p.seniority.ListUserExperienceLevels(ctx)
+	if err != nil {
+		p.log.Printf("pipeline=full step=matching_v2 status=error op=load_user_seniority err=%v", err)
+		return userRanks, jobRanks
+	}
+	for uid, raw := range levels {
+		if rank := job.SeniorityRank(job.ParseSeniority(raw)); rank > 0 {
+			userRanks[uid] = rank
+		}
+	}
+
+	seniorities, err := p.seniority.ListActiveJobSeniorities(ctx)
+	if err != nil {
+		p.log.Printf("pipeline=full step=matching_v2 status=error op=load_job_seniority err=%v", err)
+		return userRanks, jobRanks
+	}
+	for jid, level := range seniorities {
+		jobRanks[jid] = job.SeniorityRank(level)
+	}
+	return userRanks, jobRanks
+}
+
+func (p *FullPipeline) scoreAndWrite(
+	ctx context.Context,
+	engine matching.Engine,
+	units []batchUnit,
+	vectors map[uuid.UUID][]matching.UserSkillV2,
+	total int64,
+	workers int,
+	batchSize int,
+) error {
+	var scored atomic.Int64
+
+	unitCh := make(chan batchUnit)
+	rowCh := make(chan []repository.JobMatchUpsert, workers)
+
+	var wg sync.WaitGroup
+	for i := 0; i < workers; i++ {
+		wg.Add(1)
+		go func() {
+			defer wg.Done()
+			for unit := range unitCh {
+				vec := vectors[unit.user]
+				now := time.Now().UTC()
+				rows := make([]repository.JobMatchUpsert, 0, len(unit.jobs))
+				for _, j := range unit.jobs {
+					res := engine.ScoreWithSeniority(vec, j.reqs, unit.rank, j.rank)
+					rows = append(rows, repository.JobMatchUpsert{
+						UserID:         unit.user,
+						JobID:          j.id,
+						Score:          float64(res.MatchScore),
+						Components:     matchComponents(res.Breakdown),
+						ScoringProfile: res.ProfileName,
+						ScoringVersion: res.ProfileVersion,
+						MatchedAt:      now,
+					})
+				}
+				scored.Add(int64(len(rows)))
+				select {
+				case rowCh <- rows:
+				case <-ctx.Done():
+					return
+				}
+			}
+		}()
+	}
+
+	go func() {
+		defer close(unitCh)
+		for _, unit := range units {
+			select {
+			case unitCh <- unit:
+			case <-ctx.Done():
+				return
+			}
+		}
+	}()
+
+	go func() {
+		wg.Wait()
+		close(rowCh)
+	}()
+
+	var written, failed int64
+	lastProgress := time.Now()
+	buf := make([]repository.JobMatchUpsert, 0, batchSize)
+	flush := func() {
+		if len(buf) == 0 {
+			return
+		}
+		if _, err := p.matches.UpsertBatch(ctx, buf); err != nil {
+			failed += int64(len(buf))
+			p.log.Printf("pipeline=full step=matching_v2 status=error rows=%d err=%v", len(buf), err)
+		} else {
+			written += int64(len(buf))
+		}
+		buf = buf[:0]
+
+		if time.Since(lastProgress) >= matchingProgressInterval {
+			lastProgress = time.Now()
+			p.log.Printf("pipeline=full step=matching_v2 progress scored=%d/%d written=%d failed=%d", scored.Load(), total, written, failed)
+		}
+	}
+
+	for rows := range rowCh {
+		for len(rows) > 0 {
+			n := batchSize - len(buf)
+			if n > len(rows) {
+				n = len(rows)
+			}
+			buf = append(buf, rows[:n]...)
+			rows = rows[n:]
+			if len(buf) >= batchSize {
+				flush()
+			}
+		}
+	}
+	flush()
+
+	p.log.Printf("pipeline=full step=matching_v2 summary total=%d scored=%d written=%d failed=%d", total, scored.Load(), written, failed)
+	if err := ctx.Err(); err != nil {
+		return err
+	}
+	if failed > 0 {
+		return fmt.Errorf("matching_v2: %d rows failed to write", failed)
+	}
+	return nil
+}
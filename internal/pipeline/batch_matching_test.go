@@ -0,0 +1,194 @@
+package pipeline
+
+import (
+	"context"
+	"errors"
+	"io"
+	"log"
+	"testing"
+	"time"
+
+	"skill-sync/internal/domain/matching"
+	"skill-sync/internal/repository"
+
+	"github.com/google/uuid"
+)
+
+func unitJobs(units []batchUnit) map[uuid.UUID][]uuid.UUID {
+	out := make(map[uuid.UUID][]uuid.UUID, len(units))
+	for _, u := range units {
+		ids := make([]uuid.UUID, 0, len(u.jobs))
+		for _, j := range u.jobs {
+			ids = append(ids, j.id)
+		}
+		out[u.user] = ids
+	}
+	return out
+}
+
+func TestMatchingUnits(t *testing.T) {
+	alice, bob, carol := uuid.New(), uuid.New(), uuid.New()
+	j1, j2, j3 := uuid.New(), uuid.New(), uuid.New()
+	users := []uuid.UUID{alice, bob, carol}
+	jobs := []batchJob{{id: j1}, {id: j2}, {id: j3}}
+	ranks := map[uuid.UUID]int{bob: 3}
+	set := func(ids ...uuid.UUID) map[uuid.UUID]struct{} {
+		m := map[uuid.UUID]struct{}{}
+		for _, id := range ids {
+			m[id] = struct{}{}
+		}
+		return m
+	}
+
+	t.Run("full rebuild scores every pair", func(t *testing.T) {
+		got := unitJobs(matchingUnits(users, jobs, ranks, true, set(alice), set(j1)))
+		if len(got) != 3 || len(got[alice]) != 3 || len(got[bob]) != 3 || len(got[carol]) != 3 {
+			t.Fatalf("unexpected units %v", got)
+		}
+	})
+
+	t.Run("dirty user gets every job, others only dirty jobs", func(t *testing.T) {
+		units := matchingUnits(users, jobs, ranks, false, set(bob), set(j3))
+		got := unitJobs(units)
+		if len(got) != 3 || len(got[bob]) != 3 {
+			t.Fatalf("expected bob against every job, got %v", got)
+		}
+		for _, u := range []uuid.UUID{alice, carol} {
+			if len(got[u]) != 1 || got[u][0] != j3 {
+				t.Fatalf("expected %s against the dirty job only, got %v", u, got[u])
+			}
+		}
+		for _, u := range units {
+			if u.user == bob && u.rank != 3 {
+				t.Fatalf("expected bob's seniority rank to carry over, got %d", u.rank)
+			}
+		}
+	})
+
+	t.Run("only dirty users are scored when no job changed", func(t *testing.T) {
+		got := unitJobs(matchingUnits(users, jobs, ranks, false, set(carol), set()))
+		if len(got) != 1 || len(got[carol]) != 3 {
+			t.Fatalf("expected carol alone, got %v", got)
+		}
+	})
+
+	t.Run("dirty jobs that are no longer active are ignored", func(t *testing.T) {
+		got := unitJobs(matchingUnits(users, jobs, ranks, false, set(), set(uuid.New())))
+		if len(got) != 0 {
+			t.Fatalf("expected no units, got %v", got)
+		}
+	})
+}
+
+type stubDirtyRepo struct {
+	repository.MatchDirtyRepository
+	now     time.Time
+	nowErr  error
+	cleared []time.Time
+	users   []uuid.UUID
+	jobs    []uuid.UUID
+}
+
+func (s *stubDirtyRepo) ListDirtyUsers(context.Context) ([]uuid.UUID, error) { return s.users, nil }
+
+func (s *stubDirtyRepo) ListDirtyJobs(context.Context) ([]uuid.UUID, error) { return s.jobs, nil }
+
+func (s *stubDirtyRepo) Now(context.Context) (time.Time, error) { return s.now, s.nowErr }
+
+func (s *stubDirtyRepo) ClearBefore(_ context.Context, before time.Time) error {
+	s.cleared = append(s.cleared, before)
+	return nil
+}
+
+func TestDirtyCutoffUsesDatabaseClock(t *testing.T) {
+	dbNow := time.Date(2026, 1, 2, 3, 4, 5, 0, time.UTC)
+	repo := &stubDirtyRepo{now: dbNow}
+	p := &FullPipeline{dirty: repo, log: log.New(io.Discard, "", 0)}
+
+	p.clearDirty(context.Background(), p.dirtyCutoff(context.Background()))
+	if len(repo.cleared) != 1 || !repo.cleared[0].Equal(dbNow) {
+		t.Fatalf("expected marks cleared before the database time, got %v", repo.cleared)
+	}
+
+	repo.nowErr, repo.cleared = errors.New("down"), nil
+	p.clearDirty(context.Background(), p.dirtyCutoff(context.Background()))
+	if len(repo.cleared) != 0 {
+		t.Fatalf("expected no clearing without a database time, got %v", repo.cleared)
+	}
+}
+
+type stubUserQueryRepo struct {
+	repository.UserQueryRepository
+	skills map[uuid.UUID][]repository.UserSkill
+}
+
+func (s stubUserQueryRepo) ListAllUserSkills(context.Context) (map[uuid.UUID][]repository.UserSkill, error) {
+	return s.skills, nil
+}
+
+type stubJobQueryRepo struct {
+	repository.JobQueryRepository
+	reqs map[uuid.UUID][]repository.JobSkillRequirementV2
+}
+
+func (s stubJobQueryRepo) ListActiveJobRequirementsV2(context.Context) (map[uuid.UUID][]repository.JobSkillRequirementV2, error) {
+	return s.reqs, nil
+}
+
+type stubJobMatchRepo struct {
+	repository.JobMatchRepository
+	upserted     []repository.JobMatchUpsert
+	deletedUsers []uuid.UUID
+	deletedJobs  []uuid.UUID
+}
+
+func (s *stubJobMatchRepo) UpsertBatch(_ context.Context, items []repository.JobMatchUpsert) (int, error) {
+	s.upserted = append(s.upserted, items...)
+	return len(items), nil
+}
+
+func (s *stubJobMatchRepo) DeleteByUsers(_ context.Context, ids []uuid.UUID) (int64, error) {
+	s.deletedUsers = append(s.deletedUsers, ids...)
+	return int64(len(ids)), nil
+}
+
+func (s *stubJobMatchRepo) DeleteByJobs(_ context.Context, ids []uuid.UUID) (int64, error) {
+	s.deletedJobs = append(s.deletedJobs, ids...)
+	return int64(len(ids)), nil
+}
+
+func (s *stubJobMatchRepo) SnapshotUsers(context.Context, []uuid.UUID, int) (int64, error) {
+	return 0, nil
+}
+
+func TestRunMatchingBatch_DropsMatchesOfEmptiedUsersAndJobs(t *testing.T) {
+	skill := uuid.New()
+	alice, emptiedUser := uuid.New(), uuid.New()
+	kept, emptiedJob := uuid.New(), uuid.New()
+
+	matches := &stubJobMatchRepo{}
+	p := &FullPipeline{
+		users: stubUserQueryRepo{skills: map[uuid.UUID][]repository.UserSkill{
+			alice: {{SkillID: skill, SkillName: "Go", ProficiencyLevel: 3}},
+		}},
+		jobsQry: stubJobQueryRepo{reqs: map[uuid.UUID][]repository.JobSkillRequirementV2{
+			kept: {{SkillID: skill, SkillName: "Go", ImportanceWeight: 1}},
+		}},
+		matches: matches,
+		dirty:   &stubDirtyRepo{users: []uuid.UUID{emptiedUser}, jobs: []uuid.UUID{kept, emptiedJob}},
+		log:     log.New(io.Discard, "", 0),
+	}
+
+	if err := p.runMatchingBatch(context.Background(), matching.NewEngine(matching.DefaultV2Profile()), FullPipelineParams{}, time.Now()); err != nil {
+		t.Fatalf("unexpected err: %v", err)
+	}
+	if len(matches.deletedUsers) != 1 || matches.deletedUsers[0] != emptiedUser {
+		t.Fatalf("expected the skill-less user's matches dropped, got %v", matches.deletedUsers)
+	}
+	if len(matches.deletedJobs) != 1 || matches.deletedJobs[0] != emptiedJob {
+		t.Fatalf("expected the requirement-less job's matches dropped, got %v", matches.deletedJobs)
+	}
+	if len(matches.upserted) != 1 || matches.upserted[0].UserID != alice || matches.upserted[0].JobID != kept {
+		t.Fatalf("expected only alice against the changed job, got %+v", matches.upserted)
+	}
+}
@@ -5,6 +5,7 @@ import (
 	"log"
 	"time"
 
+	"skill-sync/internal/domain/matching"
 	"skill-sync/internal/repository"
 	"skill-sync/internal/usecase"
 
@@ -20,6 +21,9 @@ type FullPipeline struct {
 	users   repository.UserQueryRepository
 	jobsQry repository.JobQueryRepository
 	matches repository.JobMatchRepository
+	dirty   repository.MatchDirtyRepository
+
+	seniority repository.SeniorityRepository
 
 	log *log.Logger
 }
@@ -34,6 +38,11 @@ type FullPipelineParams struct {
 	ExtractionLimit   int
 
 	MatchingWorkers int
+	// MatchingPairwise keeps the old one-task-per-pair path; batch mode is the default.
+	MatchingPairwise  bool
+	MatchingBatchSize int
+	// MatchingFullRebuild rescores every pair instead of only dirty users and jobs.
+	MatchingFullRebuild bool
 
 	RecommendationLimit    int
 	RecommendationMinScore int
@@ -46,6 +55,8 @@ func NewFullPipeline(
 	users repository.UserQueryRepository,
 	jobsQry repository.JobQueryRepository,
 	matches repository.JobMatchRepository,
+	dirty repository.MatchDirtyRepository,
+	seniority repository.SeniorityRepository,
 	logger *log.Logger,
 ) *FullPipeline {
 	if logger == nil {
@@ -58,6 +69,8 @@ func NewFullPipeline(
 		users:           users,
 		jobsQry:         jobsQry,
 		matches:         matches,
+		dirty:           dirty,
+		seniority:       seniority,
 		log:             logger,
 	}
 }
@@ -136,6 +149,17 @@ func (p *FullPipeline) RunMatchingEngineV2(ctx context.Context, params FullPipel
 	defer func() {
 		p.log.Printf("pipeline=full step=matching_v2 status=finished duration=%s", time.Since(stepStart))
 	}()
+	dirtyCutoff := p.dirtyCutoff(ctx)
+
+	if removed, err := p.matches.DeleteForInactiveJobs(ctx); err != nil {
+		p.log.Printf("pipeline=full step=matching_v2 status=error op=drop_inactive err=%v", err)
+	} else if removed > 0 {
+		p.log.Printf("pipeline=full step=matching_v2 status=info dropped_inactive=%d", removed)
+	}
+
+	if engines, ok := p.matchingV2.(usecase.MatchEngineProvider); ok && !params.MatchingPairwise {
+		return p.runMatchingBatch(ctx, engines.Engine(), params, dirtyCutoff)
+	}
 
 	workers := params.MatchingWorkers
 	if workers <= 0 {
@@ -193,12 +217,20 @@ func (p *FullPipeline) RunMatchingEngineV2(ctx context.Context, params FullPipel
 				}
 
 				score := float64(res.MatchScore)
-				if err := p.matches.Upsert(ctx, repository.JobMatchUpsert{UserID: uid, JobID: jid, Score: score, MatchedAt: time.Now().UTC()}); err != nil {
+				if err := p.matches.Upsert(ctx, repository.JobMatchUpsert{
+					UserID:         uid,
+					JobID:          jid,
+					Score:          score,
+					Components:     matchComponents(res.Breakdown),
+					ScoringProfile: res.ProfileName,
+					ScoringVersion: res.ProfileVersion,
+					MatchedAt:      time.Now().UTC(),
+				}); err != nil {
 					p.log.Printf("pipeline=full step=matching_v2 status=error user_id=%s job_id=%s match_score=%d err=%v", uid, jid, res.MatchScore, err)
 					return Result{Err: err}
 				}
 
-				p.log.Printf("pipeline=full step=matching_v2 status=ok user_id=%s job_id=%s match_score=%d mandatory_missing=%t", uid, jid, res.MatchScore, res.MandatoryMissing)
+				p.log.Printf("pipeline=full step=matching_v2 status=ok user_id=%s job_id=%s match_score=%d mandatory_missing=%t profile=%s version=%d", uid, jid, res.MatchScore, res.MandatoryMissing, res.ProfileName, res.ProfileVersion)
 				return Result{Err: nil}
 			})
 			submitted++
@@ -215,9 +247,68 @@ func (p *FullPipeline) RunMatchingEngineV2(ctx context.Context, params FullPipel
 	}
 
 	p.log.Printf("pipeline=full step=matching_v2 summary total=%d failed=%d", submitted, failed)
+	p.snapshotUsers(ctx, userIDs)
+	// Failed pairs keep their users and jobs dirty for the next run.
+	if failed == 0 {
+		p.clearDirty(ctx, dirtyCutoff)
+	}
 	return nil
 }
 
+// snapshotUsers records each user's top-N average for /users/me/progress. It
+// is best effort and never fails the step.
+func (p *FullPipeline) snapshotUsers(ctx context.Context, userIDs []uuid.UUID) {
+	var recorded int64
+	for start := 0; start < len(userIDs); start += snapshotChunkSize {
+		end := start + snapshotChunkSize
+		if end > len(userIDs) {
+			end = len(userIDs)
+		}
+		n, err := p.matches.SnapshotUsers(ctx, userIDs[start:end], matchSnapshotTopN)
+		if err != nil {
+			p.log.Printf("pipeline=full step=matching_v2 status=error op=snapshot err=%v", err)
+			return
+		}
+		recorded += n
+	}
+	if recorded > 0 {
+		p.log.Printf("pipeline=full step=matching_v2 status=info snapshots=%d", recorded)
+	}
+}
+
+func matchComponents(b matching.ScoreBreakdown) repository.MatchComponents {
+	return repository.MatchComponents{
+		Mandatory:  b.Mandatory,
+		Optional:   b.Optional,
+		Experience: b.Experience,
+		Seniority:  b.Seniority,
+	}
+}
+
+// dirtyCutoff is the time a matching run starts from. It is read from the
+// database, which stamps the marks, so clock skew between it and this process
+// can never clear a mark made during the run. A zero time disables clearing.
+func (p *FullPipeline) dirtyCutoff(ctx context.Context) time.Time {
+	if p.dirty == nil {
+		return time.Time{}
+	}
+	now, err := p.dirty.Now(ctx)
+	if err != nil {
+		p.log.Printf("pipeline=full step=matching_v2 status=error op=dirty_cutoff err=%v", err)
+		return time.Time{}
+	}
+	return now
+}
+
+func (p *FullPipeline) clearDirty(ctx context.Context, before time.Time) {
+	if p.dirty == nil || before.IsZero() {
+		return
+	}
+	if err := p.dirty.ClearBefore(ctx, before.UTC()); err != nil {
+		p.log.Printf("pipeline=full step=matching_v2 status=error op=clear_dirty err=%v", err)
+	}
+}
+
 func (p *FullPipeline) RunRecommendations(ctx context.Context, params FullPipelineParams) error {
 	if p == nil || p.recommend == nil || p.users == nil {
 		return nil
@@ -6,6 +6,7 @@ type SemanticResponse struct {
 	Status  int         `json:"status"`
 	Message string      `json:"message"`
 	Data    interface{} `json:"data"`
+	Meta    interface{} `json:"meta,omitempty"`
 }
 
 const (
@@ -26,6 +27,14 @@ func Success(c fiber.Ctx, status int, message string, data interface{}) error {
 	return c.Status(st).JSON(SemanticResponse{Status: st, Message: msg, Data: data})
 }
 
+// SuccessWithMeta adds a meta object next to data, for details about the
+// result set that do not belong on each item.
+func SuccessWithMeta(c fiber.Ctx, status int, message string, data interface{}, meta interface{}) error {
+	st := normalizeStatus(status)
+	msg := normalizeMessage(message, st)
+	return c.Status(st).JSON(SemanticResponse{Status: st, Message: msg, Data: data, Meta: meta})
+}
+
 func Error(c fiber.Ctx, status int, message string, data interface{}) error {
 	st := normalizeStatus(status)
 	msg := normalizeMessage(message, st)
@@ -0,0 +1,279 @@
+package repository
+
+import (
+	"context"
+	"errors"
+	"time"
+
+	"skill-sync/internal/database"
+
+	"github.com/google/uuid"
+	"github.com/jackc/pgx/v5"
+)
+
+type Application struct {
+	ID           uuid.UUID
+	UserID       uuid.UUID
+	JobID        uuid.UUID
+	Title        string
+	Company      string
+	Location     string
+	Stage        string
+	Notes        string
+	ContactName  string
+	ContactEmail string
+	ContactPhone string
+	InterviewAt  *time.Time
+	// SavedMatchScore is the match score when the job was first saved;
+	// StoredMatchScore is the latest score in job_matches.
+	SavedMatchScore  *int
+	StoredMatchScore *int
+	AppliedAt        *time.Time
+	StageChangedAt   time.Time
+	CreatedAt        time.Time
+	UpdatedAt        time.Time
+}
+
+type ApplicationStageEvent struct {
+	ID        uuid.UUID
+	FromStage string
+	ToStage   string
+	Note      string
+	CreatedAt time.Time
+}
+
+type ApplicationStageCount struct {
+	Stage string
+	Count int
+}
+
+type ApplicationRepository interface {
+	Create(ctx context.Context, app Application) (uuid.UUID, error)
+	FindByID(ctx context.Context, userID, id uuid.UUID) (Application, bool, error)
+	List(ctx context.Context, userID uuid.UUID, stage string) ([]Application, error)
+	Update(ctx context.Context, app Application, stageNote string) (bool, error)
+	Delete(ctx context.Context, userID, id uuid.UUID) (bool, error)
+	ListStageEvents(ctx context.Context, applicationID uuid.UUID) ([]ApplicationStageEvent, error)
+	CountByStage(ctx context.Context, userID uuid.UUID) ([]ApplicationStageCount, error)
+}
+
+type PostgresApplicationRepository struct {
+	db database.DB
+}
+
+func NewPostgresApplicationRepository(db database.DB) *PostgresApplicationRepository {
+	return &PostgresApplicationRepository{db: db}
+}
+
+const applicationSelect = `SELECT a.id, a.user_id, a.job_id,
+	        COALESCE(j.title, ''), COALESCE(j.company, ''), COALESCE(j.location, ''),
+	        a.stage, COALESCE(a.notes, ''),
+	        COALESCE(a.contact_name, ''), COALESCE(a.contact_email, ''), COALESCE(a.contact_phone, ''),
+	        a.interview_at, a.saved_match_score, ROUND(m.match_score)::int,
+	        a.applied_at, a.stage_changed_at, a.created_at, a.updated_at
+	 FROM user_job_applications a
+	 JOIN jobs j ON j.id = a.job_id
+	 LEFT JOIN job_matches m ON m.user_id = a.user_id AND m.job_id = a.job_id`
+
+func scanApplication(row database.Row) (Application, error) {
+	var it Application
+	err := row.Scan(
+		&it.ID, &it.UserID, &it.JobID,
+		&it.Title, &it.Company, &it.Location,
+		&it.Stage, &it.Notes,
+		&it.ContactName, &it.ContactEmail, &it.ContactPhone,
+		&it.InterviewAt, &it.SavedMatchScore, &it.StoredMatchScore,
+		&it.AppliedAt, &it.StageChangedAt, &it.CreatedAt, &it.UpdatedAt,
+	)
+	return it, err
+}
+
+// Create inserts the application together with its first timeline entry.
+func (r *PostgresApplicationRepository) Create(ctx context.Context, app Application) (uuid.UUID, error) {
+	tx, err := r.db.Begin(ctx)
+	if err != nil {
+		return uuid.Nil, err
+	}
+	defer func() {
+		_ = tx.Rollback(context.Background())
+	}()
+
+	id := uuid.New()
+	if _, err := tx.Exec(ctx,
+		`INSERT INTO user_job_applications (
+			id, user_id, job_id, stage, notes, contact_name, contact_email, contact_phone,
+			interview_at, saved_match_score, applied_at
+		 ) VALUES ($1,$2,$3,$4,$5,$6,$7,$8,$9,$10,$11)`,
+		id, app.UserID, app.JobID, app.Stage,
+		nullableText(app.Notes), nullableText(app.ContactName), nullableText(app.ContactEmail), nullableText(app.ContactPhone),
+		app.InterviewAt, app.SavedMatchScore, app.AppliedAt,
+	); err != nil {
+		return uuid.Nil, err
+	}
+	if _, err := tx.Exec(ctx,
+		`INSERT INTO user_job_application_events (application_id, from_stage, to_stage) VALUES ($1, NULL, $2)`,
+		id, app.Stage,
+	); err != nil {
+		return uuid.Nil, err
+	}
+
+	if err := tx.Commit(ctx); err != nil {
+		return uuid.Nil, err
+	}
+	return id, nil
+}
+
+func (r *PostgresApplicationRepository) FindByID(ctx context.Context, userID, id uuid.UUID) (Application, bool, error) {
+	it, err := scanApplication(r.db.QueryRow(ctx, applicationSelect+` WHERE a.user_id = $1 AND a.id = $2`, userID, id))
+	if err != nil {
+		if errors.Is(err, pgx.ErrNoRows) {
+			return Application{}, false, nil
+		}
+		return Application{}, false, err
+	}
+	return it, true, nil
+}
+
+func (r *PostgresApplicationRepository) List(ctx context.Context, userID uuid.UUID, stage string) ([]Application, error) {
+	rows, err := r.db.Query(ctx,
+		applicationSelect+`
+		 WHERE a.user_id = $1 AND ($2 = '' OR a.stage = $2)
+		 ORDER BY a.updated_at DESC, a.id`,
+		userID, stage,
+	)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+
+	out := make([]Application, 0)
+	for rows.Next() {
+		it, err := scanApplication(rows)
+		if err != nil {
+			return nil, err
+		}
+		out = append(out, it)
+	}
+	if err := rows.Err(); err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+// Update writes every editable field and appends a timeline entry when the
+// stage changes.
+func (r *PostgresApplicationRepository) Update(ctx context.Context, app Application, stageNote string) (bool, error) {
+	tx, err := r.db.Begin(ctx)
+	if err != nil {
+		return false, err
+	}
+	defer func() {
+		_ = tx.Rollback(context.Background())
+	}()
+
+	var prev string
+	if err := tx.QueryRow(ctx,
+		`SELECT stage FROM user_job_applications WHERE user_id = $1 AND id = $2 FOR UPDATE`,
+		app.UserID, app.ID,
+	).Scan(&prev); err != nil {
+		if errors.Is(err, pgx.ErrNoRows) {
+			return false, nil
+		}
+		return false, err
+	}
+
+	if _, err := tx.Exec(ctx,
+		`UPDATE user_job_applications SET
+			stage = $3,
+			notes = $4,
+			contact_name = $5,
+			contact_email = $6,
+			contact_phone = $7,
+			interview_at = $8,
+			applied_at = $9,
+			stage_changed_at = CASE WHEN stage <> $3 THEN now() ELSE stage_changed_at END,
+			updated_at = now()
+		 WHERE user_id = $1 AND id = $2`,
+		app.UserID, app.ID, app.Stage,
+		nullableText(app.Notes), nullableText(app.ContactName), nullableText(app.ContactEmail), nullableText(app.ContactPhone),
+		app.InterviewAt, app.AppliedAt,
+	); err != nil {
+		return false, err
+	}
+
+	if prev != app.Stage {
+		if _, err := tx.Exec(ctx,
+			`INSERT INTO user_job_application_events (application_id, from_stage, to_stage, note) VALUES ($1,$2,$3,$4)`,
+			app.ID, prev, app.Stage, nullableText(stageNote),
+		); err != nil {
+			return false, err
+		}
+	}
+
+	if err := tx.Commit(ctx); err != nil {
+		return false, err
+	}
+	return true, nil
+}
+
+func (r *PostgresApplicationRepository) Delete(ctx context.Context, userID, id uuid.UUID) (bool, error) {
+	n, err := r.db.Exec(ctx, `DELETE FROM user_job_applications WHERE user_id = $1 AND id = $2`, userID, id)
+	if err != nil {
+		return false, err
+	}
+	return n > 0, nil
+}
+
+func (r *PostgresApplicationRepository) ListStageEvents(ctx context.Context, applicationID uuid.UUID) ([]ApplicationStageEvent, error) {
+	rows, err := r.db.Query(ctx,
+		`SELECT id, COALESCE(from_stage, ''), to_stage, COALESCE(note, ''), created_at
+		 FROM user_job_application_events
+		 WHERE application_id = $1
+		 ORDER BY created_at ASC, id`,
+		applicationID,
+	)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+
+	out := make([]ApplicationStageEvent, 0)
+	for rows.Next() {
+		var it ApplicationStageEvent
+		if err := rows.Scan(&it.ID, &it.FromStage, &it.ToStage, &it.Note, &it.CreatedAt); err != nil {
+			return nil, err
+		}
+		out = append(out, it)
+	}
+	if err := rows.Err(); err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (r *PostgresApplicationRepository) CountByStage(ctx context.Context, userID uuid.UUID) ([]ApplicationStageCount, error) {
+	rows, err := r.db.Query(ctx,
+		`SELECT stage, COUNT(*)::int
+		 FROM user_job_applications
+		 WHERE user_id = $1
+		 GROUP BY stage`,
+		userID,
+	)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+
+	out := make([]ApplicationStageCount, 0)
+	for rows.Next() {
+		var it ApplicationStageCount
+		if err := rows.Scan(&it.Stage, &it.Count); err != nil {
+			return nil, err
+		}
+		out = append(out, it)
+	}
+	if err := rows.Err(); err != nil {
+		return nil, err
+	}
+	return out, nil
+}
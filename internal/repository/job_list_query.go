@@ -0,0 +1,345 @@
+package repository
+
+import (
+	"context"
+	"encoding/json"
+	"strings"
+
+	"skill-sync/internal/domain/job"
+)
+
+const (
+	FacetLocation       = "location"
+	FacetCompany        = "company"
+	FacetSource         = "source"
+	FacetSkill          = "skill"
+	FacetEmploymentType = "employment_type"
+	FacetPosted         = "posted"
+)
+
+var JobFacetNames = []string{FacetLocation, FacetCompany, FacetSource, FacetSkill, FacetEmploymentType, FacetPosted}
+
+// Posted-date buckets do not overlap: "7d" is older than a day but at most a
+// week old, and so on.
+const (
+	PostedLast24h = "24h"
+	PostedLast7d  = "7d"
+	PostedLast30d = "30d"
+	PostedOlder   = "older"
+)
+
+var PostedBuckets = []string{PostedLast24h, PostedLast7d, PostedLast30d, PostedOlder}
+
+func IsValidPostedBucket(b string) bool {
+	for _, v := range PostedBuckets {
+		if v == b {
+			return true
+		}
+	}
+	return false
+}
+
+const postedBucketExpr = `CASE
+		WHEN COALESCE(j.posted_at, j.created_at) >= now() - interval '24 hours' THEN '24h'
+		WHEN COALESCE(j.posted_at, j.created_at) >= now() - interval '7 days' THEN '7d'
+		WHEN COALESCE(j.posted_at, j.created_at) >= now() - interval '30 days' THEN '30d'
+		ELSE 'older' END`
+
+// JobSortSalary lists the best-paid jobs first, by the top of their salary
+// range; jobs without a parsed salary come last.
+const JobSortSalary = "salary"
+
+const salarySortExpr = "COALESCE(j.salary_max, j.salary_min, -1)::float8"
+
+// listingAtExpr is the timestamp jobs are listed by; jobs without a posting
+// date fall back to when they were scraped.
+const listingAtExpr = "COALESCE(j.posted_at, j.created_at)"
+
+// ListingKey is the row's position in the listing order.
+func (r JobListRow) ListingKey() JobListKey {
+	at := r.CreatedAt
+	if r.PostedAt != nil {
+		at = *r.PostedAt
+	}
+	return JobListKey{Rank: r.SortKey, At: at, ID: r.ID}
+}
+
+type FacetCount struct {
+	Value string
+	Count int
+}
+
+type JobFacets map[string][]FacetCount
+
+// jobListQuery holds the conditions and bind arguments shared by the listing
+// and facet queries.
+type jobListQuery struct {
+	args  []any
+	where []string
+	// facetWhere holds the multi-select facet filters. They are kept apart so
+	// each facet can be counted without its own filter, which keeps the other
+	// values of that facet selectable.
+	facetWhere map[string]string
+	rankExpr   string
+	// keyExpr is the first listing-order column: rankExpr, unless the
+	// listing is sorted by something else.
+	keyExpr string
+	ranked  bool
+	// distanceExpr looks up the job's distance for Near searches.
+	distanceExpr string
+}
+
+func (q *jobListQuery) arg(v any) string {
+	q.args = append(q.args, v)
+	return "$" + itoa(len(q.args))
+}
+
+func newJobListQuery(f JobListFilter) *jobListQuery {
+	q := &jobListQuery{facetWhere: map[string]string{}, rankExpr: "0::float8", distanceExpr: "NULL::float8"}
+
+	if textQuery := strings.TrimSpace(f.TextQuery); textQuery != "" {
+		tsQuery := "websearch_to_tsquery('simple', " + q.arg(textQuery) + ")"
+		q.rankExpr = "ts_rank_cd(j.search_vector, " + tsQuery + ", 32)::float8"
+		q.where = append(q.where, "j.search_vector @@ "+tsQuery)
+		q.ranked = true
+	} else if fuzzy := strings.ToLower(strings.TrimSpace(f.FuzzyTitle)); fuzzy != "" {
+		p := q.arg(fuzzy)
+		q.rankExpr = "word_similarity(" + p + ", lower(j.title))::float8"
+		q.where = append(q.where, p+" <% lower(j.title)")
+		q.ranked = true
+	} else if patterns := likePatterns(f.TitleVariants); len(patterns) > 0 {
+		q.where = append(q.where, "j.title ILIKE ANY("+q.arg(patterns)+")")
+	} else if strings.TrimSpace(f.Title) != "" {
+		q.where = append(q.where, "j.title ILIKE "+q.arg("%"+strings.TrimSpace(f.Title)+"%"))
+	}
+	if strings.TrimSpace(f.CompanyName) != "" {
+		q.where = append(q.where, "j.company ILIKE "+q.arg("%"+strings.TrimSpace(f.CompanyName)+"%"))
+	}
+	if loc := strings.TrimSpace(f.Location); loc != "" {
+		q.where = append(q.where, q.locationCondition(loc))
+	}
+	if len(f.Near) > 0 {
+		ids := make([]string, 0, len(f.Near))
+		kms := make([]float64, 0, len(f.Near))
+		for _, n := range f.Near {
+			ids = append(ids, n.ID)
+			kms = append(kms, n.Km)
+		}
+		idsArg, kmsArg := q.arg(ids), q.arg(kms)
+		q.where = append(q.where, "j.location_id = ANY("+idsArg+"::text[])")
+		q.distanceExpr = "(" + kmsArg + "::float8[])[array_position(" + idsArg + "::text[], j.location_id)]"
+	}
+	if f.SalaryMin > 0 {
+		q.where = append(q.where, "COALESCE(j.salary_max, j.salary_min) >= "+q.arg(f.SalaryMin))
+	}
+	if f.SalaryMax > 0 {
+		q.where = append(q.where, "COALESCE(j.salary_min, j.salary_max) <= "+q.arg(f.SalaryMax))
+	}
+	if len(f.Seniority) > 0 {
+		q.where = append(q.where, "j.seniority = ANY("+q.arg(f.Seniority)+")")
+	}
+	if len(f.JobIDs) > 0 {
+		q.where = append(q.where, "j.id = ANY("+q.arg(f.JobIDs)+")")
+	}
+	if len(f.ExcludeJobIDs) > 0 {
+		q.where = append(q.where, "NOT (j.id = ANY("+q.arg(f.ExcludeJobIDs)+"))")
+	}
+	if len(f.ExcludeCompanyKeys) > 0 {
+		q.where = append(q.where, "NOT (lower(btrim(COALESCE(j.company, ''))) = ANY("+q.arg(f.ExcludeCompanyKeys)+"))")
+	}
+
+	if patterns := likePatterns(f.Skills); len(patterns) > 0 {
+		q.facetWhere[FacetSkill] = "EXISTS (SELECT 1 FROM job_skills js JOIN skills s ON s.id = js.skill_id " +
+			"WHERE js.job_id = j.id AND s.name ILIKE ANY(" + q.arg(patterns) + "))"
+	}
+	if keys := lowerKeys(f.Locations); len(keys) > 0 {
+		q.facetWhere[FacetLocation] = "lower(btrim(COALESCE(j.location, ''))) = ANY(" + q.arg(keys) + ")"
+	}
+	if keys := lowerKeys(f.Companies); len(keys) > 0 {
+		q.facetWhere[FacetCompany] = "lower(btrim(COALESCE(j.company, ''))) = ANY(" + q.arg(keys) + ")"
+	}
+	if keys := lowerKeys(f.Sources); len(keys) > 0 {
+		q.facetWhere[FacetSource] = "lower(COALESCE(j.source, 'unknown')) = ANY(" + q.arg(keys) + ")"
+	}
+	if keys := lowerKeys(f.EmploymentTypes); len(keys) > 0 {
+		q.facetWhere[FacetEmploymentType] = "lower(btrim(COALESCE(j.employment_type, ''))) = ANY(" + q.arg(keys) + ")"
+	}
+	if len(f.PostedBuckets) > 0 {
+		q.facetWhere[FacetPosted] = "(" + postedBucketExpr + ") = ANY(" + q.arg(f.PostedBuckets) + ")"
+	}
+
+	q.keyExpr = q.rankExpr
+	if f.Sort == JobSortSalary {
+		q.keyExpr = salarySortExpr
+		q.ranked = true
+	}
+	return q
+}
+
+// locationCondition matches a place and everything inside it when the filter
+// names a gazetteer location, so "Jakarta" covers all five kota. Jobs stored
+// before location IDs existed, and unrecognised filters, fall back to a
+// substring match on the scraped text.
+func (q *jobListQuery) locationCondition(raw string) string {
+	like := q.arg("%" + raw + "%")
+	loc, ok := job.ResolveLocation(raw)
+	if !ok {
+		return "j.location ILIKE " + like
+	}
+	return "(j.location_id = " + q.arg(loc.ID) + " OR j.location_id LIKE " + q.arg(loc.ID+"/%") +
+		" OR (j.location_id IS NULL AND j.location ILIKE " + like + "))"
+}
+
+// whereSQL renders every condition, leaving out the facet filter named by
+// except (pass "" to keep them all).
+func (q *jobListQuery) whereSQL(except string) string {
+	conds := append([]string{"1=1"}, q.where...)
+	for _, name := range JobFacetNames {
+		if c, ok := q.facetWhere[name]; ok && name != except {
+			conds = append(conds, c)
+		}
+	}
+	return strings.Join(conds, " AND ")
+}
+
+func (r *PostgresJobRepository) ListJobFacets(ctx context.Context, f JobListFilter, limit int) (JobFacets, error) {
+	if limit <= 0 {
+		limit = 20
+	}
+	q := newJobListQuery(f)
+	// One flag per facet filter, so a single scan can count every facet
+	// against all filters but its own.
+	flags := make([]string, 0, len(JobFacetNames))
+	for _, name := range JobFacetNames {
+		cond := "true"
+		if c, ok := q.facetWhere[name]; ok {
+			cond = c
+		}
+		flags = append(flags, "("+cond+") AS f_"+name)
+	}
+	others := func(name string) string {
+		conds := make([]string, 0, len(JobFacetNames))
+		for _, n := range JobFacetNames {
+			if n != name {
+				conds = append(conds, "b.f_"+n)
+			}
+		}
+		return strings.Join(conds, " AND ")
+	}
+	limitArg := q.arg(limit)
+	column := func(name, expr string) string {
+		return `(SELECT '` + name + `', MIN(btrim(` + expr + `)), COUNT(*)::int
+			FROM base b
+			WHERE ` + others(name) + ` AND btrim(COALESCE(` + expr + `, '')) <> ''
+			GROUP BY lower(btrim(` + expr + `))
+			ORDER BY 3 DESC, 2 ASC
+			LIMIT ` + limitArg + `)`
+	}
+
+	sql := `WITH base AS (
+		SELECT j.id, j.location, j.company, COALESCE(j.source, 'unknown') AS source, j.employment_type,
+			` + postedBucketExpr + ` AS posted_bucket,
+			` + strings.Join(flags, ",\n\t\t\t") + `
+		FROM jobs j
+		WHERE ` + strings.Join(append([]string{"1=1"}, q.where...), " AND ") + `
+	)
+	` + column(FacetLocation, "b.location") + `
+	UNION ALL ` + column(FacetCompany, "b.company") + `
+	UNION ALL ` + column(FacetSource, "b.source") + `
+	UNION ALL ` + column(FacetEmploymentType, "b.employment_type") + `
+	UNION ALL (SELECT '` + FacetSkill + `', MIN(s.name), COUNT(DISTINCT b.id)::int
+		FROM base b
+		JOIN job_skills js ON js.job_id = b.id
+		JOIN skills s ON s.id = js.skill_id
+		WHERE ` + others(FacetSkill) + `
+		GROUP BY lower(s.name)
+		ORDER BY 3 DESC, 2 ASC
+		LIMIT ` + limitArg + `)
+	UNION ALL (SELECT '` + FacetPosted + `', b.posted_bucket, COUNT(*)::int
+		FROM base b
+		WHERE ` + others(FacetPosted) + `
+		GROUP BY b.posted_bucket)`
+
+	rows, err := r.db.Query(ctx, sql, q.args...)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+
+	out := make(JobFacets, len(JobFacetNames))
+	for _, name := range JobFacetNames {
+		out[name] = []FacetCount{}
+	}
+	posted := map[string]int{}
+	for rows.Next() {
+		var name string
+		var fc FacetCount
+		if err := rows.Scan(&name, &fc.Value, &fc.Count); err != nil {
+			return nil, err
+		}
+		if name == FacetPosted {
+			posted[fc.Value] = fc.Count
+			continue
+		}
+		out[name] = append(out[name], fc)
+	}
+	if err := rows.Err(); err != nil {
+		return nil, err
+	}
+	// Buckets are always listed, in age order, so the sidebar stays stable.
+	for _, b := range PostedBuckets {
+		out[FacetPosted] = append(out[FacetPosted], FacetCount{Value: b, Count: posted[b]})
+	}
+	return out, nil
+}
+
+// CountJobsForListing counts the jobs matching the filter. Without exact it
+// returns the planner's row estimate, which is cheap but can be well off for
+// narrow filters.
+func (r *PostgresJobRepository) CountJobsForListing(ctx context.Context, f JobListFilter, exact bool) (int, error) {
+	f.After = nil
+	q := newJobListQuery(f)
+	if exact {
+		var n int
+		err := r.db.QueryRow(ctx, `SELECT COUNT(*)::int FROM jobs j WHERE `+q.whereSQL(""), q.args...).Scan(&n)
+		return n, err
+	}
+
+	var plan []byte
+	if err := r.db.QueryRow(ctx, `EXPLAIN (FORMAT JSON) SELECT 1 FROM jobs j WHERE `+q.whereSQL(""), q.args...).Scan(&plan); err != nil {
+		return 0, err
+	}
+	var out []struct {
+		Plan struct {
+			Rows float64 `json:"Plan Rows"`
+		} `json:"Plan"`
+	}
+	if err := json.Unmarshal(plan, &out); err != nil || len(out) == 0 {
+		return 0, err
+	}
+	return int(out[0].Plan.Rows), nil
+}
+
+func likePatterns(values []string) []string {
+	out := make([]string, 0, len(values))
+	for _, v := range values {
+		v = strings.TrimSpace(v)
+		if v == "" {
+			continue
+		}
+		out = append(out, "%"+v+"%")
+	}
+	return out
+}
+
+func lowerKeys(values []string) []string {
+	out := make([]string, 0, len(values))
+	for _, v := range values {
+		v = strings.ToLower(strings.TrimSpace(v))
+		if v == "" {
+			continue
+		}
+		out = append(out, v)
+	}
+	return out
+}
@@ -0,0 +1,68 @@
+package repository
+
+import (
+	"context"
+
+	"skill-sync/internal/database"
+
+	"github.com/google/uuid"
+)
+
+type JobForLocation struct {
+	ID       uuid.UUID
+	Location string
+}
+
+type JobLocationRepository interface {
+	ListJobsForLocation(ctx context.Context, afterID uuid.UUID, onlyMissing bool, limit int) ([]JobForLocation, error)
+	UpdateJobLocationID(ctx context.Context, jobID uuid.UUID, locationID string) error
+}
+
+type PostgresJobLocationRepository struct {
+	db database.DB
+}
+
+func NewPostgresJobLocationRepository(db database.DB) *PostgresJobLocationRepository {
+	return &PostgresJobLocationRepository{db: db}
+}
+
+// ListJobsForLocation pages through jobs with a location by id for
+// backfilling.
+func (r *PostgresJobLocationRepository) ListJobsForLocation(ctx context.Context, afterID uuid.UUID, onlyMissing bool, limit int) ([]JobForLocation, error) {
+	if limit <= 0 {
+		limit = 500
+	}
+	rows, err := r.db.Query(ctx,
+		`SELECT id, location
+		 FROM jobs
+		 WHERE id > $1 AND btrim(COALESCE(location, '')) <> '' AND ($2 = false OR location_id IS NULL)
+		 ORDER BY id ASC
+		 LIMIT $3`,
+		afterID, onlyMissing, limit,
+	)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+
+	out := make([]JobForLocation, 0)
+	for rows.Next() {
+		var it JobForLocation
+		if err := rows.Scan(&it.ID, &it.Location); err != nil {
+			return nil, err
+		}
+		out = append(out, it)
+	}
+	if err := rows.Err(); err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (r *PostgresJobLocationRepository) UpdateJobLocationID(ctx context.Context, jobID uuid.UUID, locationID string) error {
+	_, err := r.db.Exec(ctx,
+		`UPDATE jobs SET location_id = $2 WHERE id = $1 AND location_id IS DISTINCT FROM $2`,
+		jobID, nullableText(locationID),
+	)
+	return err
+}
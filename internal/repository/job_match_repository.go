@@ -10,14 +10,39 @@ import (
 )
 
 type JobMatchUpsert struct {
-	UserID    uuid.UUID
-	JobID     uuid.UUID
-	Score     float64
-	MatchedAt time.Time
+	UserID         uuid.UUID
+	JobID          uuid.UUID
+	Score          float64
+	Components     MatchComponents
+	ScoringProfile string
+	ScoringVersion int
+	MatchedAt      time.Time
+}
+
+// MatchComponents is the score breakdown kept in job_match_history.
+type MatchComponents struct {
+	Mandatory  float64
+	Optional   float64
+	Experience float64
+	Seniority  float64
+}
+
+type JobMatchRow struct {
+	JobID          uuid.UUID
+	Score          float64
+	ScoringProfile string
+	ScoringVersion int
+	MatchedAt      time.Time
 }
 
 type JobMatchRepository interface {
 	Upsert(ctx context.Context, m JobMatchUpsert) error
+	UpsertBatch(ctx context.Context, items []JobMatchUpsert) (int, error)
+	SnapshotUsers(ctx context.Context, userIDs []uuid.UUID, topN int) (int64, error)
+	ListTopByUser(ctx context.Context, userID uuid.UUID, limit int) ([]JobMatchRow, error)
+	DeleteForInactiveJobs(ctx context.Context) (int64, error)
+	DeleteByUsers(ctx context.Context, userIDs []uuid.UUID) (int64, error)
+	DeleteByJobs(ctx context.Context, jobIDs []uuid.UUID) (int64, error)
 }
 
 type PostgresJobMatchRepository struct {
@@ -28,6 +53,29 @@ func NewPostgresJobMatchRepository(db database.DB) *PostgresJobMatchRepository {
 	return &PostgresJobMatchRepository{db: db}
 }
 
+// matchHistoryChanged keeps job_match_history to real changes: a recomputed
+// pair is recorded when its score, any score component, scoring profile or
+// version differs from the pair's last history row, or it has none. Rerunning
+// the matcher on unchanged data therefore adds nothing. Values are compared at
+// the two decimals the history stores.
+const matchHistoryChanged = `NOT EXISTS (
+				SELECT 1 FROM (
+					SELECT h.match_score, h.mandatory_score, h.optional_score, h.experience_score, h.seniority_score,
+					       h.scoring_profile, h.scoring_version
+					FROM job_match_history h
+					WHERE h.user_id = i.user_id AND h.job_id = i.job_id
+					ORDER BY h.recorded_at DESC, h.id DESC
+					LIMIT 1
+				) last
+				WHERE last.match_score = ROUND(i.match_score, 2)
+				  AND last.mandatory_score IS NOT DISTINCT FROM ROUND(i.mandatory_score, 2)
+				  AND last.optional_score IS NOT DISTINCT FROM ROUND(i.optional_score, 2)
+				  AND last.experience_score IS NOT DISTINCT FROM ROUND(i.experience_score, 2)
+				  AND last.seniority_score IS NOT DISTINCT FROM ROUND(i.seniority_score, 2)
+				  AND last.scoring_profile IS NOT DISTINCT FROM i.scoring_profile
+				  AND last.scoring_version IS NOT DISTINCT FROM i.scoring_version
+			)`
+
 func (r *PostgresJobMatchRepository) Upsert(ctx context.Context, m JobMatchUpsert) error {
 	if m.UserID == uuid.Nil || m.JobID == uuid.Nil {
 		return nil
@@ -37,16 +85,230 @@ func (r *PostgresJobMatchRepository) Upsert(ctx context.Context, m JobMatchUpser
 	}
 
 	_, err := r.db.Exec(ctx,
-		`INSERT INTO job_matches (id, user_id, job_id, match_score, matched_at)
-		 VALUES ($1,$2,$3,$4,$5)
+		`WITH input AS (
+			SELECT $2::uuid AS user_id, $3::uuid AS job_id, ROUND($4::numeric, 2) AS match_score,
+			       ROUND($8::numeric, 2) AS mandatory_score, ROUND($9::numeric, 2) AS optional_score,
+			       ROUND($10::numeric, 2) AS experience_score, ROUND($11::numeric, 2) AS seniority_score,
+			       $5::text AS scoring_profile, $6::int AS scoring_version, $7::timestamptz AS recorded_at
+		 ), hist AS (
+			INSERT INTO job_match_history (
+				user_id, job_id, match_score, mandatory_score, optional_score, experience_score, seniority_score,
+				scoring_profile, scoring_version, recorded_at
+			)
+			SELECT i.* FROM input i
+			WHERE `+matchHistoryChanged+`
+		 )
+		 INSERT INTO job_matches (id, user_id, job_id, match_score, scoring_profile, scoring_version, matched_at)
+		 VALUES ($1, $2, $3, $4::numeric, $5::text, $6::int, $7::timestamptz)
 		 ON CONFLICT (user_id, job_id) DO UPDATE SET
 			match_score = EXCLUDED.match_score,
+			scoring_profile = EXCLUDED.scoring_profile,
+			scoring_version = EXCLUDED.scoring_version,
 			matched_at = EXCLUDED.matched_at`,
 		uuid.New(),
 		m.UserID,
 		m.JobID,
 		m.Score,
+		nullableText(m.ScoringProfile),
+		nullableInt(m.ScoringVersion),
 		m.MatchedAt,
+		m.Components.Mandatory,
+		m.Components.Optional,
+		m.Components.Experience,
+		m.Components.Seniority,
 	)
 	return err
 }
+
+// UpsertBatch writes many rows in one statement by unnesting parallel arrays.
+// Pairs whose result changed are also appended to job_match_history, see
+// matchHistoryChanged.
+func (r *PostgresJobMatchRepository) UpsertBatch(ctx context.Context, items []JobMatchUpsert) (int, error) {
+	if len(items) == 0 {
+		return 0, nil
+	}
+
+	now := time.Now().UTC()
+	ids := make([]uuid.UUID, 0, len(items))
+	userIDs := make([]uuid.UUID, 0, len(items))
+	jobIDs := make([]uuid.UUID, 0, len(items))
+	scores := make([]float64, 0, len(items))
+	profiles := make([]*string, 0, len(items))
+	versions := make([]*int32, 0, len(items))
+	matchedAt := make([]time.Time, 0, len(items))
+	mandatory := make([]float64, 0, len(items))
+	optional := make([]float64, 0, len(items))
+	experience := make([]float64, 0, len(items))
+	seniority := make([]float64, 0, len(items))
+	for _, m := range items {
+		if m.UserID == uuid.Nil || m.JobID == uuid.Nil {
+			continue
+		}
+		if m.MatchedAt.IsZero() {
+			m.MatchedAt = now
+		}
+		ids = append(ids, uuid.New())
+		userIDs = append(userIDs, m.UserID)
+		jobIDs = append(jobIDs, m.JobID)
+		scores = append(scores, m.Score)
+		var profile *string
+		if m.ScoringProfile != "" {
+			v := m.ScoringProfile
+			profile = &v
+		}
+		profiles = append(profiles, profile)
+		var version *int32
+		if m.ScoringVersion > 0 {
+			v := int32(m.ScoringVersion)
+			version = &v
+		}
+		versions = append(versions, version)
+		matchedAt = append(matchedAt, m.MatchedAt)
+		mandatory = append(mandatory, m.Components.Mandatory)
+		optional = append(optional, m.Components.Optional)
+		experience = append(experience, m.Components.Experience)
+		seniority = append(seniority, m.Components.Seniority)
+	}
+	if len(ids) == 0 {
+		return 0, nil
+	}
+
+	affected, err := r.db.Exec(ctx,
+		`WITH input AS (
+			SELECT * FROM unnest(
+				$1::uuid[], $2::uuid[], $3::uuid[], $4::numeric[], $5::text[], $6::int[], $7::timestamptz[],
+				$8::numeric[], $9::numeric[], $10::numeric[], $11::numeric[]
+			) AS t(id, user_id, job_id, match_score, scoring_profile, scoring_version, matched_at,
+			       mandatory_score, optional_score, experience_score, seniority_score)
+		 ), hist AS (
+			INSERT INTO job_match_history (
+				user_id, job_id, match_score, mandatory_score, optional_score, experience_score, seniority_score,
+				scoring_profile, scoring_version, recorded_at
+			)
+			SELECT i.user_id, i.job_id, ROUND(i.match_score, 2), ROUND(i.mandatory_score, 2), ROUND(i.optional_score, 2),
+			       ROUND(i.experience_score, 2), ROUND(i.seniority_score, 2), i.scoring_profile, i.scoring_version, i.matched_at
+			FROM input i
+			WHERE `+matchHistoryChanged+`
+		 )
+		 INSERT INTO job_matches (id, user_id, job_id, match_score, scoring_profile, scoring_version, matched_at)
+		 SELECT id, user_id, job_id, match_score, scoring_profile, scoring_version, matched_at FROM input
+		 ON CONFLICT (user_id, job_id) DO UPDATE SET
+			match_score = EXCLUDED.match_score,
+			scoring_profile = EXCLUDED.scoring_profile,
+			scoring_version = EXCLUDED.scoring_version,
+			matched_at = EXCLUDED.matched_at`,
+		ids, userIDs, jobIDs, scores, profiles, versions, matchedAt,
+		mandatory, optional, experience, seniority,
+	)
+	if err != nil {
+		return 0, err
+	}
+	return int(affected), nil
+}
+
+func (r *PostgresJobMatchRepository) ListTopByUser(ctx context.Context, userID uuid.UUID, limit int) ([]JobMatchRow, error) {
+	if limit <= 0 {
+		limit = 20
+	}
+	if limit > 500 {
+		limit = 500
+	}
+
+	rows, err := r.db.Query(ctx,
+		`SELECT jm.job_id,
+		        COALESCE(jm.match_score, 0)::float8,
+		        COALESCE(jm.scoring_profile, ''),
+		        COALESCE(jm.scoring_version, 0),
+		        COALESCE(jm.matched_at, now())
+		 FROM job_matches jm
+		 JOIN jobs j ON j.id = jm.job_id
+		 WHERE jm.user_id = $1 AND j.is_active = true
+		 ORDER BY jm.match_score DESC NULLS LAST, jm.matched_at DESC
+		 LIMIT $2`,
+		userID, limit,
+	)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+
+	out := make([]JobMatchRow, 0)
+	for rows.Next() {
+		var it JobMatchRow
+		if err := rows.Scan(&it.JobID, &it.Score, &it.ScoringProfile, &it.ScoringVersion, &it.MatchedAt); err != nil {
+			return nil, err
+		}
+		out = append(out, it)
+	}
+	if err := rows.Err(); err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (r *PostgresJobMatchRepository) DeleteForInactiveJobs(ctx context.Context) (int64, error) {
+	return r.db.Exec(ctx,
+		`DELETE FROM job_matches jm
+		 USING jobs j
+		 WHERE j.id = jm.job_id AND j.is_active = false`,
+	)
+}
+
+func (r *PostgresJobMatchRepository) DeleteByUsers(ctx context.Context, userIDs []uuid.UUID) (int64, error) {
+	if len(userIDs) == 0 {
+		return 0, nil
+	}
+	return r.db.Exec(ctx, `DELETE FROM job_matches WHERE user_id = ANY($1)`, userIDs)
+}
+
+func (r *PostgresJobMatchRepository) DeleteByJobs(ctx context.Context, jobIDs []uuid.UUID) (int64, error) {
+	if len(jobIDs) == 0 {
+		return 0, nil
+	}
+	return r.db.Exec(ctx, `DELETE FROM job_matches WHERE job_id = ANY($1)`, jobIDs)
+}
+
+// SnapshotUsers records the average of each user's top-N active matches,
+// skipping users whose average and scoring version are unchanged since their
+// last snapshot.
+func (r *PostgresJobMatchRepository) SnapshotUsers(ctx context.Context, userIDs []uuid.UUID, topN int) (int64, error) {
+	if len(userIDs) == 0 {
+		return 0, nil
+	}
+	if topN <= 0 {
+		topN = 10
+	}
+	return r.db.Exec(ctx,
+		`WITH ranked AS (
+			SELECT jm.user_id, jm.match_score, jm.scoring_profile, jm.scoring_version,
+			       COUNT(*) OVER (PARTITION BY jm.user_id) AS matched_jobs,
+			       ROW_NUMBER() OVER (PARTITION BY jm.user_id ORDER BY jm.match_score DESC NULLS LAST, jm.job_id) AS rn
+			FROM job_matches jm
+			JOIN jobs j ON j.id = jm.job_id
+			WHERE jm.user_id = ANY($1) AND j.is_active = true AND jm.match_score IS NOT NULL
+		 ), latest AS (
+			SELECT user_id,
+			       ROUND(AVG(match_score), 2) AS top_avg,
+			       MAX(matched_jobs)::int AS matched_jobs,
+			       MAX(scoring_profile) AS scoring_profile,
+			       MAX(scoring_version) AS scoring_version
+			FROM ranked
+			WHERE rn <= $2
+			GROUP BY user_id
+		 )
+		 INSERT INTO user_match_snapshots (user_id, top_n, top_avg, matched_jobs, scoring_profile, scoring_version)
+		 SELECT c.user_id, $2, c.top_avg, c.matched_jobs, c.scoring_profile, c.scoring_version
+		 FROM latest c
+		 LEFT JOIN LATERAL (
+			SELECT s.top_n, s.top_avg, s.scoring_version
+			FROM user_match_snapshots s
+			WHERE s.user_id = c.user_id
+			ORDER BY s.recorded_at DESC, s.id DESC
+			LIMIT 1
+		 ) last ON true
+		 WHERE last.top_avg IS DISTINCT FROM c.top_avg
+		    OR last.top_n IS DISTINCT FROM $2::smallint
+		    OR last.scoring_version IS DISTINCT FROM c.scoring_version`,
+		userIDs, topN,
+	)
+}
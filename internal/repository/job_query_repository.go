@@ -12,6 +12,8 @@ type JobQueryRepository interface {
 	CountJobs(ctx context.Context) (int, error)
 	CountJobSkills(ctx context.Context) (int, error)
 	ListJobIDsWithSkills(ctx context.Context, limit, offset int) ([]uuid.UUID, error)
+	ListActiveJobRequirementsV2(ctx context.Context) (map[uuid.UUID][]JobSkillRequirementV2, error)
+	ListJobIDsBySkills(ctx context.Context, skillIDs []uuid.UUID, limit int) ([]uuid.UUID, error)
 }
 
 type PostgresJobQueryRepository struct {
@@ -78,3 +80,80 @@ func (r *PostgresJobQueryRepository) ListJobIDsWithSkills(ctx context.Context, l
 	}
 	return out, nil
 }
+
+func (r *PostgresJobQueryRepository) ListActiveJobRequirementsV2(ctx context.Context) (map[uuid.UUID][]JobSkillRequirementV2, error) {
+	rows, err := r.db.Query(ctx,
+		`SELECT js.job_id,
+		        js.skill_id,
+		        s.name,
+		        js.required_level,
+		        js.is_mandatory,
+		        js.required_years,
+		        COALESCE(js.importance_weight, 0)
+		 FROM job_skills js
+		 JOIN skills s ON s.id = js.skill_id
+		 JOIN jobs j ON j.id = js.job_id
+		 WHERE j.is_active = true
+		 ORDER BY js.job_id ASC, s.name ASC`,
+	)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+
+	out := make(map[uuid.UUID][]JobSkillRequirementV2)
+	for rows.Next() {
+		var jobID uuid.UUID
+		it, err := scanJobSkillRequirementV2(rows, &jobID)
+		if err != nil {
+			return nil, err
+		}
+		out[jobID] = append(out[jobID], it)
+	}
+	if err := rows.Err(); err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+// ListJobIDsBySkills walks the job_skills skill index and returns active jobs
+// sharing at least one of the given skills, most overlapping first.
+func (r *PostgresJobQueryRepository) ListJobIDsBySkills(ctx context.Context, skillIDs []uuid.UUID, limit int) ([]uuid.UUID, error) {
+	if len(skillIDs) == 0 {
+		return []uuid.UUID{}, nil
+	}
+	if limit <= 0 {
+		limit = 1000
+	}
+	if limit > 5000 {
+		limit = 5000
+	}
+
+	rows, err := r.db.Query(ctx,
+		`SELECT js.job_id
+		 FROM job_skills js
+		 JOIN jobs j ON j.id = js.job_id
+		 WHERE js.skill_id = ANY($1) AND j.is_active = true
+		 GROUP BY js.job_id, j.posted_at, j.created_at
+		 ORDER BY COUNT(*) DESC, j.posted_at DESC NULLS LAST, j.created_at DESC
+		 LIMIT $2`,
+		skillIDs, limit,
+	)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+
+	out := make([]uuid.UUID, 0)
+	for rows.Next() {
+		var id uuid.UUID
+		if err := rows.Scan(&id); err != nil {
+			return nil, err
+		}
+		out = append(out, id)
+	}
+	if err := rows.Err(); err != nil {
+		return nil, err
+	}
+	return out, nil
+}
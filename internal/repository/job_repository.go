@@ -9,6 +9,7 @@ import (
 	"time"
 
 	"skill-sync/internal/database"
+	"skill-sync/internal/domain/job"
 
 	"github.com/google/uuid"
 	"github.com/jackc/pgx/v5"
@@ -21,7 +22,10 @@ var (
 type JobRepository interface {
 	ExistsByID(ctx context.Context, jobID uuid.UUID) (bool, error)
 	ListJobs(ctx context.Context, limit, offset int) ([]Job, error)
+	FindByIDs(ctx context.Context, jobIDs []uuid.UUID) ([]Job, error)
 	ListJobsForListing(ctx context.Context, f JobListFilter) ([]JobListRow, error)
+	ListJobFacets(ctx context.Context, f JobListFilter, limit int) (JobFacets, error)
+	CountJobsForListing(ctx context.Context, f JobListFilter, exact bool) (int, error)
 	ListActiveJobsWithoutSkills(ctx context.Context, limit, offset int) ([]JobForSkillExtraction, error)
 	GetLatestScrapedAt(ctx context.Context, title string, location string) (time.Time, error)
 	UpsertJobs(ctx context.Context, jobs []JobUpsert) error
@@ -48,6 +52,8 @@ type JobUpsert struct {
 	PostedAt       *time.Time
 	ScrapedAt      *time.Time
 	IsActive       bool
+	// Seniority is classified from the title and description when empty.
+	Seniority string
 }
 
 type JobForSkillExtraction struct {
@@ -63,8 +69,55 @@ type JobListFilter struct {
 	CompanyName   string
 	Location      string
 	Skills        []string
-	Limit         int
-	Offset        int
+	Seniority     []string
+	// TextQuery, when set, is matched against jobs.search_vector with
+	// websearch_to_tsquery and results are ordered by rank; it takes the
+	// place of the ILIKE title filter.
+	TextQuery string
+	// FuzzyTitle, when set without TextQuery, matches titles by pg_trgm word
+	// similarity so misspelt queries still find jobs; results are ordered by
+	// similarity.
+	FuzzyTitle string
+	// JobIDs, when set, restricts the search to these jobs.
+	JobIDs []uuid.UUID
+	// Exclusions come from the requesting user's dismissed jobs and blocked companies.
+	ExcludeJobIDs      []uuid.UUID
+	ExcludeCompanyKeys []string
+	// Multi-select facet filters; values within one facet are OR-ed.
+	// Locations, Companies, Sources and EmploymentTypes match exact values
+	// case-insensitively, PostedBuckets takes PostedBuckets entries.
+	Locations       []string
+	Companies       []string
+	Sources         []string
+	EmploymentTypes []string
+	PostedBuckets   []string
+	// Near restricts jobs to these gazetteer locations, each with its
+	// distance from the search centre, which is returned as DistanceKm.
+	Near []job.LocationDistance
+	// SalaryMin and SalaryMax, in IDR per month, keep jobs whose salary range
+	// reaches SalaryMin and starts at or below SalaryMax. Jobs without a
+	// parsed salary are left out when either is set.
+	SalaryMin int
+	SalaryMax int
+	// Sort selects a listing order other than relevance and recency; the
+	// only one is JobSortSalary.
+	Sort string
+	// After, when set, continues the listing from a keyset position and
+	// Offset is ignored.
+	After  *JobListKey
+	Limit  int
+	Offset int
+}
+
+// JobListKey is a position in the listing order: rank (or the salary for
+// JobSortSalary), then
+// COALESCE(posted_at, created_at), then id, all descending. Backward pages
+// return the rows before the key, still in listing order.
+type JobListKey struct {
+	Rank     float64
+	At       time.Time
+	ID       uuid.UUID
+	Backward bool
 }
 
 type JobFreshnessFilter struct {
@@ -80,8 +133,20 @@ type JobListRow struct {
 	Source      string
 	SourceURL   string
 	Description string
+	Seniority   string
 	PostedAt    *time.Time
 	CreatedAt   time.Time
+	// TextRank is ts_rank_cd normalised to 0..1, or the title similarity for
+	// FuzzyTitle searches; zero otherwise.
+	TextRank float64
+	// SourceWeight is the source's job_sources.ranking_weight.
+	SourceWeight float64
+	// DistanceKm is set for Near searches.
+	DistanceKm *float64
+	// SortKey is the first listing-order column: TextRank, or the top of the
+	// salary range for JobSortSalary.
+	SortKey float64
+	Salary  *job.Salary
 }
 
 type PostgresJobRepository struct {
@@ -141,88 +206,90 @@ func (r *PostgresJobRepository) ListJobs(ctx context.Context, limit, offset int)
 	return out, nil
 }
 
+func (r *PostgresJobRepository) FindByIDs(ctx context.Context, jobIDs []uuid.UUID) ([]Job, error) {
+	if len(jobIDs) == 0 {
+		return []Job{}, nil
+	}
+
+	rows, err := r.db.Query(ctx,
+		`SELECT id, COALESCE(title, ''), COALESCE(company, ''), COALESCE(location, '')
+		 FROM jobs
+		 WHERE id = ANY($1)`,
+		jobIDs,
+	)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+
+	out := make([]Job, 0, len(jobIDs))
+	for rows.Next() {
+		var j Job
+		if err := rows.Scan(&j.ID, &j.Title, &j.Company, &j.Location); err != nil {
+			return nil, err
+		}
+		out = append(out, j)
+	}
+	if err := rows.Err(); err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
 func (r *PostgresJobRepository) ListJobsForListing(ctx context.Context, f JobListFilter) ([]JobListRow, error) {
 	limit := f.Limit
 	if limit <= 0 {
 		limit = 20
 	}
-	if limit > 50 {
-		limit = 50
+	// One row past the page size lets callers tell whether another page exists.
+	if limit > 51 {
+		limit = 51
 	}
 	offset := f.Offset
-	if offset < 0 {
+	if offset < 0 || f.After != nil {
 		offset = 0
 	}
 
-	base := strings.Builder{}
-	base.WriteString(`SELECT j.id,
+	q := newJobListQuery(f)
+	dir := "DESC"
+	if f.After != nil {
+		op := "<"
+		if f.After.Backward {
+			op, dir = ">", "ASC"
+		}
+		q.where = append(q.where, "("+q.keyExpr+", "+listingAtExpr+", j.id) "+op+
+			" ("+q.arg(f.After.Rank)+", "+q.arg(f.After.At)+", "+q.arg(f.After.ID)+")")
+	}
+	query := `SELECT j.id,
 		COALESCE(j.title, ''),
 		COALESCE(j.company, ''),
 		COALESCE(j.location, ''),
 		COALESCE(j.source, 'unknown'),
 		COALESCE(j.source_url, j.url, ''),
 		COALESCE(j.description, ''),
+		COALESCE(j.seniority, ''),
 		j.posted_at,
-		j.created_at
+		j.created_at,
+		` + q.rankExpr + ` AS text_rank,
+		COALESCE(src.ranking_weight, 1)::float8,
+		` + q.distanceExpr + ` AS distance_km,
+		` + q.keyExpr + ` AS sort_key,
+		j.salary_min,
+		j.salary_max,
+		COALESCE(j.salary_currency, ''),
+		COALESCE(j.salary_period, '')
 		FROM jobs j
-		WHERE 1=1`)
+		LEFT JOIN job_sources src ON src.id = j.source_id
+		WHERE ` + q.whereSQL("")
 
-	args := make([]any, 0, 6)
-	argN := 1
-
-	if len(f.TitleVariants) > 0 {
-		patterns := make([]string, 0, len(f.TitleVariants))
-		for _, t := range f.TitleVariants {
-			t = strings.TrimSpace(t)
-			if t == "" {
-				continue
-			}
-			patterns = append(patterns, "%"+t+"%")
-		}
-		if len(patterns) > 0 {
-			base.WriteString(" AND j.title ILIKE ANY($" + itoa(argN) + ")")
-			args = append(args, patterns)
-			argN++
-		}
-	} else if strings.TrimSpace(f.Title) != "" {
-		base.WriteString(" AND j.title ILIKE $" + itoa(argN))
-		args = append(args, "%"+strings.TrimSpace(f.Title)+"%")
-		argN++
-	}
-	if strings.TrimSpace(f.CompanyName) != "" {
-		base.WriteString(" AND j.company ILIKE $" + itoa(argN))
-		args = append(args, "%"+strings.TrimSpace(f.CompanyName)+"%")
-		argN++
-	}
-	if strings.TrimSpace(f.Location) != "" {
-		base.WriteString(" AND j.location ILIKE $" + itoa(argN))
-		args = append(args, "%"+strings.TrimSpace(f.Location)+"%")
-		argN++
-	}
-	if len(f.Skills) > 0 {
-		patterns := make([]string, 0, len(f.Skills))
-		for _, s := range f.Skills {
-			s = strings.TrimSpace(s)
-			if s == "" {
-				continue
-			}
-			patterns = append(patterns, "%"+s+"%")
-		}
-		if len(patterns) > 0 {
-			base.WriteString(" AND EXISTS (")
-			base.WriteString("SELECT 1 FROM job_skills js JOIN skills s ON s.id = js.skill_id ")
-			base.WriteString("WHERE js.job_id = j.id AND s.name ILIKE ANY($" + itoa(argN) + ")")
-			base.WriteString(")")
-			args = append(args, patterns)
-			argN++
-		}
+	if q.ranked {
+		query += " ORDER BY sort_key " + dir + ", " + listingAtExpr + " " + dir + ", j.id " + dir
+	} else {
+		query += " ORDER BY " + listingAtExpr + " " + dir + ", j.id " + dir
 	}
+	query += " LIMIT " + q.arg(limit) + " OFFSET " + q.arg(offset)
 
-	base.WriteString(" ORDER BY j.posted_at DESC NULLS LAST, j.created_at DESC")
-	base.WriteString(" LIMIT $" + itoa(argN) + " OFFSET $" + itoa(argN+1))
-	args = append(args, limit, offset)
-
-	rows, err := r.db.Query(ctx, base.String(), args...)
+	rows, err := r.db.Query(ctx, query, q.args...)
 	if err != nil {
 		return nil, err
 	}
@@ -232,18 +299,34 @@ func (r *PostgresJobRepository) ListJobsForListing(ctx context.Context, f JobLis
 	for rows.Next() {
 		var it JobListRow
 		var posted sql.NullTime
-		if err := rows.Scan(&it.ID, &it.Title, &it.Company, &it.Location, &it.Source, &it.SourceURL, &it.Description, &posted, &it.CreatedAt); err != nil {
+		var distance sql.NullFloat64
+		var salaryMin, salaryMax sql.NullInt64
+		var salaryCurrency, salaryPeriod string
+		if err := rows.Scan(&it.ID, &it.Title, &it.Company, &it.Location, &it.Source, &it.SourceURL, &it.Description, &it.Seniority, &posted, &it.CreatedAt, &it.TextRank, &it.SourceWeight, &distance,
+			&it.SortKey, &salaryMin, &salaryMax, &salaryCurrency, &salaryPeriod); err != nil {
 			return nil, err
 		}
 		if posted.Valid {
 			t := posted.Time
 			it.PostedAt = &t
 		}
+		if distance.Valid {
+			d := distance.Float64
+			it.DistanceKm = &d
+		}
+		if salaryMin.Valid || salaryMax.Valid {
+			it.Salary = &job.Salary{Min: int(salaryMin.Int64), Max: int(salaryMax.Int64), Currency: salaryCurrency, Period: salaryPeriod}
+		}
 		out = append(out, it)
 	}
 	if err := rows.Err(); err != nil {
 		return nil, err
 	}
+	if f.After != nil && f.After.Backward {
+		for i, j := 0, len(out)-1; i < j; i, j = i+1, j-1 {
+			out[i], out[j] = out[j], out[i]
+		}
+	}
 	return out, nil
 }
 
@@ -342,12 +425,22 @@ func (r *PostgresJobRepository) UpsertJobs(ctx context.Context, jobs []JobUpsert
 		if !isActive {
 			isActive = true
 		}
+		seniority := strings.TrimSpace(j.Seniority)
+		if !job.IsValidSeniority(seniority) {
+			seniority = job.ClassifySeniority(j.Title, j.Description)
+		}
+		salaryText := j.Description
+		if strings.TrimSpace(salaryText) == "" {
+			salaryText = j.RawDescription
+		}
+		salary, _ := job.JobSalary(j.Title, salaryText)
 
 		_, err := tx.Exec(ctx,
 			`INSERT INTO jobs (
 				id, source_id, external_job_id, title, company, location, employment_type,
-				description, raw_description, posted_at, scraped_at, url, source_url, is_active
-			) VALUES ($1,$2,$3,$4,$5,$6,$7,$8,$9,$10,$11,$12,$13,$14)
+				description, raw_description, posted_at, scraped_at, url, source_url, is_active, seniority, location_id,
+				salary_min, salary_max, salary_currency, salary_period
+			) VALUES ($1,$2,$3,$4,$5,$6,$7,$8,$9,$10,$11,$12,$13,$14,$15,$16,$17,$18,$19,$20)
 			ON CONFLICT (source_id, url) DO NOTHING`,
 			uuid.New(),
 			sourceID,
@@ -363,6 +456,12 @@ func (r *PostgresJobRepository) UpsertJobs(ctx context.Context, jobs []JobUpsert
 			nullableText(sourceURL),
 			nullableText(sourceURL),
 			isActive,
+			nullableText(seniority),
+			nullableText(job.LocationID(j.Location)),
+			nullableInt(salary.Min),
+			nullableInt(salary.Max),
+			nullableText(salary.Currency),
+			nullableText(salary.Period),
 		)
 		if err != nil {
 			return err
@@ -408,6 +507,13 @@ func nullableText(s string) any {
 	return s
 }
 
+func nullableInt(v int) any {
+	if v <= 0 {
+		return nil
+	}
+	return v
+}
+
 func (r *PostgresJobRepository) ListActiveJobsWithoutSkills(ctx context.Context, limit, offset int) ([]JobForSkillExtraction, error) {
 	if limit <= 0 {
 		limit = 50
@@ -100,6 +100,10 @@ func (r *PostgresJobRequiredSkillRepository) UpsertForJob(ctx context.Context, j
 		}
 	}
 
+	if _, err := tx.Exec(ctx, markJobsDirtySQL, []uuid.UUID{jobID}); err != nil {
+		return err
+	}
+
 	if err := tx.Commit(ctx); err != nil {
 		return err
 	}
@@ -0,0 +1,73 @@
+package repository
+
+import (
+	"context"
+
+	"skill-sync/internal/database"
+	"skill-sync/internal/domain/job"
+
+	"github.com/google/uuid"
+)
+
+type JobForSalary struct {
+	ID          uuid.UUID
+	Title       string
+	Description string
+}
+
+type JobSalaryRepository interface {
+	ListJobsForSalary(ctx context.Context, afterID uuid.UUID, onlyMissing bool, limit int) ([]JobForSalary, error)
+	UpdateJobSalary(ctx context.Context, jobID uuid.UUID, salary job.Salary) error
+}
+
+type PostgresJobSalaryRepository struct {
+	db database.DB
+}
+
+func NewPostgresJobSalaryRepository(db database.DB) *PostgresJobSalaryRepository {
+	return &PostgresJobSalaryRepository{db: db}
+}
+
+// ListJobsForSalary pages through jobs by id for backfilling. Description
+// falls back to raw_description, as it does at ingestion.
+func (r *PostgresJobSalaryRepository) ListJobsForSalary(ctx context.Context, afterID uuid.UUID, onlyMissing bool, limit int) ([]JobForSalary, error) {
+	if limit <= 0 {
+		limit = 500
+	}
+	rows, err := r.db.Query(ctx,
+		`SELECT id, COALESCE(title, ''), COALESCE(NULLIF(btrim(description), ''), raw_description, '')
+		 FROM jobs
+		 WHERE id > $1 AND ($2 = false OR (salary_min IS NULL AND salary_max IS NULL))
+		 ORDER BY id ASC
+		 LIMIT $3`,
+		afterID, onlyMissing, limit,
+	)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+
+	out := make([]JobForSalary, 0)
+	for rows.Next() {
+		var it JobForSalary
+		if err := rows.Scan(&it.ID, &it.Title, &it.Description); err != nil {
+			return nil, err
+		}
+		out = append(out, it)
+	}
+	if err := rows.Err(); err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+// UpdateJobSalary stores a parsed salary; the zero Salary clears it.
+func (r *PostgresJobSalaryRepository) UpdateJobSalary(ctx context.Context, jobID uuid.UUID, salary job.Salary) error {
+	_, err := r.db.Exec(ctx,
+		`UPDATE jobs
+		 SET salary_min = $2, salary_max = $3, salary_currency = $4, salary_period = $5
+		 WHERE id = $1`,
+		jobID, nullableInt(salary.Min), nullableInt(salary.Max), nullableText(salary.Currency), nullableText(salary.Period),
+	)
+	return err
+}
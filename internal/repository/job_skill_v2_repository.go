@@ -20,6 +20,7 @@ type JobSkillRequirementV2 struct {
 
 type JobSkillV2Repository interface {
 	FindByJobIDV2(ctx context.Context, jobID uuid.UUID) ([]JobSkillRequirementV2, error)
+	FindByJobIDsV2(ctx context.Context, jobIDs []uuid.UUID) (map[uuid.UUID][]JobSkillRequirementV2, error)
 }
 
 type PostgresJobSkillV2Repository struct {
@@ -51,25 +52,10 @@ func (r *PostgresJobSkillV2Repository) FindByJobIDV2(ctx context.Context, jobID
 
 	out := make([]JobSkillRequirementV2, 0)
 	for rows.Next() {
-		var it JobSkillRequirementV2
-		var requiredLevel sql.NullInt32
-		var isMandatory sql.NullBool
-		var requiredYears sql.NullInt32
-		if err := rows.Scan(&it.SkillID, &it.SkillName, &requiredLevel, &isMandatory, &requiredYears, &it.ImportanceWeight); err != nil {
+		it, err := scanJobSkillRequirementV2(rows, nil)
+		if err != nil {
 			return nil, err
 		}
-		if requiredLevel.Valid {
-			v := int(requiredLevel.Int32)
-			it.RequiredLevel = &v
-		}
-		if isMandatory.Valid {
-			v := isMandatory.Bool
-			it.IsMandatory = &v
-		}
-		if requiredYears.Valid {
-			v := int(requiredYears.Int32)
-			it.RequiredYears = &v
-		}
 		out = append(out, it)
 	}
 	if err := rows.Err(); err != nil {
@@ -77,3 +63,72 @@ func (r *PostgresJobSkillV2Repository) FindByJobIDV2(ctx context.Context, jobID
 	}
 	return out, nil
 }
+
+func (r *PostgresJobSkillV2Repository) FindByJobIDsV2(ctx context.Context, jobIDs []uuid.UUID) (map[uuid.UUID][]JobSkillRequirementV2, error) {
+	out := make(map[uuid.UUID][]JobSkillRequirementV2, len(jobIDs))
+	if len(jobIDs) == 0 {
+		return out, nil
+	}
+
+	rows, err := r.db.Query(ctx,
+		`SELECT js.job_id,
+		        js.skill_id,
+		        s.name,
+		        js.required_level,
+		        js.is_mandatory,
+		        js.required_years,
+		        COALESCE(js.importance_weight, 0)
+		 FROM job_skills js
+		 JOIN skills s ON s.id = js.skill_id
+		 WHERE js.job_id = ANY($1)
+		 ORDER BY js.job_id ASC, s.name ASC`,
+		jobIDs,
+	)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+
+	for rows.Next() {
+		var jobID uuid.UUID
+		it, err := scanJobSkillRequirementV2(rows, &jobID)
+		if err != nil {
+			return nil, err
+		}
+		out[jobID] = append(out[jobID], it)
+	}
+	if err := rows.Err(); err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+// scanJobSkillRequirementV2 scans a requirement row; when jobID is non-nil the
+// row is expected to start with the job_id column.
+func scanJobSkillRequirementV2(rows database.Rows, jobID *uuid.UUID) (JobSkillRequirementV2, error) {
+	var it JobSkillRequirementV2
+	var requiredLevel sql.NullInt32
+	var isMandatory sql.NullBool
+	var requiredYears sql.NullInt32
+
+	dest := []any{&it.SkillID, &it.SkillName, &requiredLevel, &isMandatory, &requiredYears, &it.ImportanceWeight}
+	if jobID != nil {
+		dest = append([]any{jobID}, dest...)
+	}
+	if err := rows.Scan(dest...); err != nil {
+		return JobSkillRequirementV2{}, err
+	}
+	if requiredLevel.Valid {
+		v := int(requiredLevel.Int32)
+		it.RequiredLevel = &v
+	}
+	if isMandatory.Valid {
+		v := isMandatory.Bool
+		it.IsMandatory = &v
+	}
+	if requiredYears.Valid {
+		v := int(requiredYears.Int32)
+		it.RequiredYears = &v
+	}
+	return it, nil
+}
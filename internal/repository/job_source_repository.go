@@ -0,0 +1,82 @@
+package repository
+
+import (
+	"context"
+	"errors"
+	"time"
+
+	"skill-sync/internal/database"
+
+	"github.com/google/uuid"
+	"github.com/jackc/pgx/v5"
+)
+
+type JobSource struct {
+	ID            uuid.UUID
+	Name          string
+	BaseURL       string
+	RankingWeight float64
+	ActiveJobs    int
+	CreatedAt     time.Time
+}
+
+type JobSourceRepository interface {
+	ListSources(ctx context.Context) ([]JobSource, error)
+	UpdateRankingWeight(ctx context.Context, id uuid.UUID, weight float64) (JobSource, bool, error)
+}
+
+type PostgresJobSourceRepository struct {
+	db database.DB
+}
+
+func NewPostgresJobSourceRepository(db database.DB) *PostgresJobSourceRepository {
+	return &PostgresJobSourceRepository{db: db}
+}
+
+const jobSourceColumns = `s.id, COALESCE(s.name, ''), COALESCE(s.base_url, ''), s.ranking_weight::float8,
+	(SELECT COUNT(*)::int FROM jobs j WHERE j.source_id = s.id AND j.is_active = true),
+	COALESCE(s.created_at, 'epoch'::timestamptz)`
+
+func scanJobSource(row database.Row) (JobSource, error) {
+	var it JobSource
+	if err := row.Scan(&it.ID, &it.Name, &it.BaseURL, &it.RankingWeight, &it.ActiveJobs, &it.CreatedAt); err != nil {
+		return JobSource{}, err
+	}
+	return it, nil
+}
+
+func (r *PostgresJobSourceRepository) ListSources(ctx context.Context) ([]JobSource, error) {
+	rows, err := r.db.Query(ctx, `SELECT `+jobSourceColumns+` FROM job_sources s ORDER BY s.name ASC`)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+
+	out := make([]JobSource, 0)
+	for rows.Next() {
+		it, err := scanJobSource(rows)
+		if err != nil {
+			return nil, err
+		}
+		out = append(out, it)
+	}
+	if err := rows.Err(); err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (r *PostgresJobSourceRepository) UpdateRankingWeight(ctx context.Context, id uuid.UUID, weight float64) (JobSource, bool, error) {
+	it, err := scanJobSource(r.db.QueryRow(ctx,
+		`UPDATE job_sources s SET ranking_weight = $2 WHERE s.id = $1 RETURNING `+jobSourceColumns,
+		id,
+		weight,
+	))
+	if err != nil {
+		if errors.Is(err, pgx.ErrNoRows) {
+			return JobSource{}, false, nil
+		}
+		return JobSource{}, false, err
+	}
+	return it, true, nil
+}
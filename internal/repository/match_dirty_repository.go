@@ -0,0 +1,121 @@
+package repository
+
+import (
+	"context"
+	"time"
+
+	"skill-sync/internal/database"
+
+	"github.com/google/uuid"
+)
+
+type MatchDirtyRepository interface {
+	MarkUsers(ctx context.Context, userIDs ...uuid.UUID) error
+	MarkJobs(ctx context.Context, jobIDs ...uuid.UUID) error
+	// MarkAllUsers is for changes that can move every user's scores, such
+	// as a skill relation edit.
+	MarkAllUsers(ctx context.Context) error
+	ListDirtyUsers(ctx context.Context) ([]uuid.UUID, error)
+	ListDirtyJobs(ctx context.Context) ([]uuid.UUID, error)
+	IsUserDirty(ctx context.Context, userID uuid.UUID) (bool, error)
+	// Now reads the database clock that marked_at is stamped with; ClearBefore
+	// cutoffs must come from it, not from the caller's clock.
+	Now(ctx context.Context) (time.Time, error)
+	// ClearBefore removes marks set before the given time so that changes made
+	// while a matching run was in progress are picked up by the next run.
+	ClearBefore(ctx context.Context, before time.Time) error
+}
+
+type PostgresMatchDirtyRepository struct {
+	db database.DB
+}
+
+func NewPostgresMatchDirtyRepository(db database.DB) *PostgresMatchDirtyRepository {
+	return &PostgresMatchDirtyRepository{db: db}
+}
+
+func (r *PostgresMatchDirtyRepository) MarkUsers(ctx context.Context, userIDs ...uuid.UUID) error {
+	if len(userIDs) == 0 {
+		return nil
+	}
+	_, err := r.db.Exec(ctx,
+		`INSERT INTO match_dirty_users (user_id, marked_at)
+		 SELECT DISTINCT unnest($1::uuid[]), now()
+		 ON CONFLICT (user_id) DO UPDATE SET marked_at = EXCLUDED.marked_at`,
+		userIDs,
+	)
+	return err
+}
+
+func (r *PostgresMatchDirtyRepository) MarkJobs(ctx context.Context, jobIDs ...uuid.UUID) error {
+	if len(jobIDs) == 0 {
+		return nil
+	}
+	_, err := r.db.Exec(ctx, markJobsDirtySQL, jobIDs)
+	return err
+}
+
+func (r *PostgresMatchDirtyRepository) MarkAllUsers(ctx context.Context) error {
+	_, err := r.db.Exec(ctx,
+		`INSERT INTO match_dirty_users (user_id, marked_at)
+		 SELECT id, now() FROM users
+		 ON CONFLICT (user_id) DO UPDATE SET marked_at = EXCLUDED.marked_at`,
+	)
+	return err
+}
+
+const markJobsDirtySQL = `INSERT INTO match_dirty_jobs (job_id, marked_at)
+	 SELECT DISTINCT unnest($1::uuid[]), now()
+	 ON CONFLICT (job_id) DO UPDATE SET marked_at = EXCLUDED.marked_at`
+
+func (r *PostgresMatchDirtyRepository) ListDirtyUsers(ctx context.Context) ([]uuid.UUID, error) {
+	return r.listIDs(ctx, `SELECT user_id FROM match_dirty_users ORDER BY marked_at ASC`)
+}
+
+func (r *PostgresMatchDirtyRepository) ListDirtyJobs(ctx context.Context) ([]uuid.UUID, error) {
+	return r.listIDs(ctx, `SELECT job_id FROM match_dirty_jobs ORDER BY marked_at ASC`)
+}
+
+func (r *PostgresMatchDirtyRepository) IsUserDirty(ctx context.Context, userID uuid.UUID) (bool, error) {
+	var exists bool
+	row := r.db.QueryRow(ctx, `SELECT EXISTS(SELECT 1 FROM match_dirty_users WHERE user_id = $1)`, userID)
+	if err := row.Scan(&exists); err != nil {
+		return false, err
+	}
+	return exists, nil
+}
+
+func (r *PostgresMatchDirtyRepository) Now(ctx context.Context) (time.Time, error) {
+	var now time.Time
+	err := r.db.QueryRow(ctx, `SELECT now()`).Scan(&now)
+	return now, err
+}
+
+func (r *PostgresMatchDirtyRepository) ClearBefore(ctx context.Context, before time.Time) error {
+	if _, err := r.db.Exec(ctx, `DELETE FROM match_dirty_users WHERE marked_at < $1`, before); err != nil {
+		return err
+	}
+	_, err := r.db.Exec(ctx, `DELETE FROM match_dirty_jobs WHERE marked_at < $1`, before)
+	return err
+}
+
+func (r *PostgresMatchDirtyRepository) listIDs(ctx context.Context, query string) ([]uuid.UUID, error) {
+	rows, err := r.db.Query(ctx, query)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+
+	out := make([]uuid.UUID, 0)
+	for rows.Next() {
+		var id uuid.UUID
+		if err := rows.Scan(&id); err != nil {
+			return nil, err
+		}
+		out = append(out, id)
+	}
+	if err := rows.Err(); err != nil {
+		return nil, err
+	}
+	return out, nil
+}
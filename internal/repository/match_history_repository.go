@@ -0,0 +1,160 @@
+package repository
+
+import (
+	"context"
+	"time"
+
+	"skill-sync/internal/database"
+
+	"github.com/google/uuid"
+)
+
+type MatchSnapshot struct {
+	TopN           int
+	TopAverage     float64
+	MatchedJobs    int
+	ScoringProfile string
+	ScoringVersion int
+	RecordedAt     time.Time
+}
+
+type SkillEvent struct {
+	SkillID        uuid.UUID
+	SkillName      string
+	Action         string
+	OldProficiency *int
+	NewProficiency *int
+	OldYears       *int
+	NewYears       *int
+	CreatedAt      time.Time
+}
+
+type MatchHistoryEntry struct {
+	Score          float64
+	Components     MatchComponents
+	ScoringProfile string
+	ScoringVersion int
+	RecordedAt     time.Time
+}
+
+type MatchHistoryRepository interface {
+	ListSnapshots(ctx context.Context, userID uuid.UUID, since time.Time, limit int) ([]MatchSnapshot, error)
+	ListSkillEvents(ctx context.Context, userID uuid.UUID, since time.Time) ([]SkillEvent, error)
+	ListPairHistory(ctx context.Context, userID, jobID uuid.UUID, limit int) ([]MatchHistoryEntry, error)
+}
+
+type PostgresMatchHistoryRepository struct {
+	db database.DB
+}
+
+func NewPostgresMatchHistoryRepository(db database.DB) *PostgresMatchHistoryRepository {
+	return &PostgresMatchHistoryRepository{db: db}
+}
+
+// ListSnapshots returns the snapshots since the given time in ascending order,
+// preceded by the last one before it so the first change can be computed.
+func (r *PostgresMatchHistoryRepository) ListSnapshots(ctx context.Context, userID uuid.UUID, since time.Time, limit int) ([]MatchSnapshot, error) {
+	if limit <= 0 {
+		limit = 200
+	}
+	rows, err := r.db.Query(ctx,
+		`SELECT top_n, top_avg::float8, matched_jobs, COALESCE(scoring_profile, ''), COALESCE(scoring_version, 0), recorded_at
+		 FROM (
+			(SELECT * FROM user_match_snapshots
+			 WHERE user_id = $1 AND recorded_at < $2
+			 ORDER BY recorded_at DESC, id DESC
+			 LIMIT 1)
+			UNION ALL
+			(SELECT * FROM user_match_snapshots
+			 WHERE user_id = $1 AND recorded_at >= $2
+			 ORDER BY recorded_at DESC, id DESC
+			 LIMIT $3)
+		 ) s
+		 ORDER BY recorded_at ASC, id ASC`,
+		userID, since, limit,
+	)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+
+	out := make([]MatchSnapshot, 0)
+	for rows.Next() {
+		var it MatchSnapshot
+		if err := rows.Scan(&it.TopN, &it.TopAverage, &it.MatchedJobs, &it.ScoringProfile, &it.ScoringVersion, &it.RecordedAt); err != nil {
+			return nil, err
+		}
+		out = append(out, it)
+	}
+	if err := rows.Err(); err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (r *PostgresMatchHistoryRepository) ListSkillEvents(ctx context.Context, userID uuid.UUID, since time.Time) ([]SkillEvent, error) {
+	rows, err := r.db.Query(ctx,
+		`SELECT e.skill_id, COALESCE(s.name, ''), e.action,
+		        e.old_proficiency, e.new_proficiency, e.old_years, e.new_years, e.created_at
+		 FROM user_skill_events e
+		 LEFT JOIN skills s ON s.id = e.skill_id
+		 WHERE e.user_id = $1 AND e.created_at >= $2
+		 ORDER BY e.created_at ASC, e.id ASC`,
+		userID, since,
+	)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+
+	out := make([]SkillEvent, 0)
+	for rows.Next() {
+		var it SkillEvent
+		if err := rows.Scan(&it.SkillID, &it.SkillName, &it.Action, &it.OldProficiency, &it.NewProficiency, &it.OldYears, &it.NewYears, &it.CreatedAt); err != nil {
+			return nil, err
+		}
+		out = append(out, it)
+	}
+	if err := rows.Err(); err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (r *PostgresMatchHistoryRepository) ListPairHistory(ctx context.Context, userID, jobID uuid.UUID, limit int) ([]MatchHistoryEntry, error) {
+	if limit <= 0 {
+		limit = 100
+	}
+	rows, err := r.db.Query(ctx,
+		`SELECT match_score::float8,
+		        COALESCE(mandatory_score, 0)::float8, COALESCE(optional_score, 0)::float8,
+		        COALESCE(experience_score, 0)::float8, COALESCE(seniority_score, 0)::float8,
+		        COALESCE(scoring_profile, ''), COALESCE(scoring_version, 0), recorded_at
+		 FROM job_match_history
+		 WHERE user_id = $1 AND job_id = $2
+		 ORDER BY recorded_at DESC, id DESC
+		 LIMIT $3`,
+		userID, jobID, limit,
+	)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+
+	out := make([]MatchHistoryEntry, 0)
+	for rows.Next() {
+		var it MatchHistoryEntry
+		if err := rows.Scan(
+			&it.Score,
+			&it.Components.Mandatory, &it.Components.Optional, &it.Components.Experience, &it.Components.Seniority,
+			&it.ScoringProfile, &it.ScoringVersion, &it.RecordedAt,
+		); err != nil {
+			return nil, err
+		}
+		out = append(out, it)
+	}
+	if err := rows.Err(); err != nil {
+		return nil, err
+	}
+	return out, nil
+}
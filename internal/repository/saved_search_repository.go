@@ -0,0 +1,327 @@
+package repository
+
+import (
+	"context"
+	"errors"
+	"time"
+
+	"skill-sync/internal/database"
+
+	"github.com/google/uuid"
+	"github.com/jackc/pgx/v5"
+)
+
+type SavedSearch struct {
+	ID            uuid.UUID
+	UserID        uuid.UUID
+	Name          string
+	Title         string
+	CompanyName   string
+	Location      string
+	Skills        []string
+	Seniority     []string
+	Notify        string
+	UnseenMatches int
+	LastMatchedAt *time.Time
+	CreatedAt     time.Time
+	UpdatedAt     time.Time
+}
+
+type SavedSearchMatch struct {
+	JobID       uuid.UUID
+	Title       string
+	CompanyName string
+	Location    string
+	MatchedAt   time.Time
+	SeenAt      *time.Time
+}
+
+type NewJobRef struct {
+	ID        uuid.UUID
+	CreatedAt time.Time
+}
+
+type SavedSearchRepository interface {
+	Create(ctx context.Context, s SavedSearch) (uuid.UUID, error)
+	FindByID(ctx context.Context, userID, id uuid.UUID) (SavedSearch, bool, error)
+	ListByUser(ctx context.Context, userID uuid.UUID) ([]SavedSearch, error)
+	CountByUser(ctx context.Context, userID uuid.UUID) (int, error)
+	Update(ctx context.Context, s SavedSearch) (bool, error)
+	Delete(ctx context.Context, userID, id uuid.UUID) (bool, error)
+	ListMatches(ctx context.Context, searchID uuid.UUID, unseenOnly bool, limit, offset int) ([]SavedSearchMatch, error)
+	MarkMatchesSeen(ctx context.Context, searchID uuid.UUID) (int64, error)
+
+	ListAll(ctx context.Context) ([]SavedSearch, error)
+	InsertMatches(ctx context.Context, searchID uuid.UUID, jobIDs []uuid.UUID) ([]uuid.UUID, error)
+	// Now reads the database clock that ListNewJobs compares against; the
+	// first alert cursor must be seeded from it, not from the caller's clock.
+	Now(ctx context.Context) (time.Time, error)
+	AlertCursor(ctx context.Context) (NewJobRef, bool, error)
+	SaveAlertCursor(ctx context.Context, cursor NewJobRef) error
+	ListNewJobs(ctx context.Context, after NewJobRef, settle time.Duration, limit int) ([]NewJobRef, error)
+}
+
+type PostgresSavedSearchRepository struct {
+	db database.DB
+}
+
+func NewPostgresSavedSearchRepository(db database.DB) *PostgresSavedSearchRepository {
+	return &PostgresSavedSearchRepository{db: db}
+}
+
+const savedSearchSelect = `SELECT s.id, s.user_id, s.name, s.title, s.company_name, s.location, s.skills, s.seniority, s.notify,
+	        (SELECT COUNT(*)::int FROM saved_search_matches m WHERE m.saved_search_id = s.id AND m.seen_at IS NULL),
+	        s.last_matched_at, s.created_at, s.updated_at
+	 FROM saved_searches s`
+
+func scanSavedSearch(row database.Row) (SavedSearch, error) {
+	var it SavedSearch
+	err := row.Scan(
+		&it.ID, &it.UserID, &it.Name, &it.Title, &it.CompanyName, &it.Location, &it.Skills, &it.Seniority, &it.Notify,
+		&it.UnseenMatches, &it.LastMatchedAt, &it.CreatedAt, &it.UpdatedAt,
+	)
+	return it, err
+}
+
+func (r *PostgresSavedSearchRepository) Create(ctx context.Context, s SavedSearch) (uuid.UUID, error) {
+	id := uuid.New()
+	_, err := r.db.Exec(ctx,
+		`INSERT INTO saved_searches (id, user_id, name, title, company_name, location, skills, seniority, notify)
+		 VALUES ($1,$2,$3,$4,$5,$6,$7,$8,$9)`,
+		id, s.UserID, s.Name, s.Title, s.CompanyName, s.Location, nonNilStrings(s.Skills), nonNilStrings(s.Seniority), s.Notify,
+	)
+	if err != nil {
+		return uuid.Nil, err
+	}
+	return id, nil
+}
+
+func (r *PostgresSavedSearchRepository) FindByID(ctx context.Context, userID, id uuid.UUID) (SavedSearch, bool, error) {
+	it, err := scanSavedSearch(r.db.QueryRow(ctx, savedSearchSelect+` WHERE s.user_id = $1 AND s.id = $2`, userID, id))
+	if err != nil {
+		if errors.Is(err, pgx.ErrNoRows) {
+			return SavedSearch{}, false, nil
+		}
+		return SavedSearch{}, false, err
+	}
+	return it, true, nil
+}
+
+func (r *PostgresSavedSearchRepository) ListByUser(ctx context.Context, userID uuid.UUID) ([]SavedSearch, error) {
+	return r.list(ctx, savedSearchSelect+` WHERE s.user_id = $1 ORDER BY s.created_at DESC, s.id`, userID)
+}
+
+func (r *PostgresSavedSearchRepository) ListAll(ctx context.Context) ([]SavedSearch, error) {
+	return r.list(ctx, savedSearchSelect+` ORDER BY s.user_id, s.created_at`)
+}
+
+func (r *PostgresSavedSearchRepository) list(ctx context.Context, query string, args ...any) ([]SavedSearch, error) {
+	rows, err := r.db.Query(ctx, query, args...)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+
+	out := make([]SavedSearch, 0)
+	for rows.Next() {
+		it, err := scanSavedSearch(rows)
+		if err != nil {
+			return nil, err
+		}
+		out = append(out, it)
+	}
+	if err := rows.Err(); err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (r *PostgresSavedSearchRepository) CountByUser(ctx context.Context, userID uuid.UUID) (int, error) {
+	var n int
+	if err := r.db.QueryRow(ctx, `SELECT COUNT(*)::int FROM saved_searches WHERE user_id = $1`, userID).Scan(&n); err != nil {
+		return 0, err
+	}
+	return n, nil
+}
+
+func (r *PostgresSavedSearchRepository) Update(ctx context.Context, s SavedSearch) (bool, error) {
+	n, err := r.db.Exec(ctx,
+		`UPDATE saved_searches SET
+			name = $3,
+			title = $4,
+			company_name = $5,
+			location = $6,
+			skills = $7,
+			seniority = $8,
+			notify = $9,
+			updated_at = now()
+		 WHERE user_id = $1 AND id = $2`,
+		s.UserID, s.ID, s.Name, s.Title, s.CompanyName, s.Location, nonNilStrings(s.Skills), nonNilStrings(s.Seniority), s.Notify,
+	)
+	if err != nil {
+		return false, err
+	}
+	return n > 0, nil
+}
+
+func (r *PostgresSavedSearchRepository) Delete(ctx context.Context, userID, id uuid.UUID) (bool, error) {
+	n, err := r.db.Exec(ctx, `DELETE FROM saved_searches WHERE user_id = $1 AND id = $2`, userID, id)
+	if err != nil {
+		return false, err
+	}
+	return n > 0, nil
+}
+
+func (r *PostgresSavedSearchRepository) ListMatches(ctx context.Context, searchID uuid.UUID, unseenOnly bool, limit, offset int) ([]SavedSearchMatch, error) {
+	if limit <= 0 {
+		limit = 20
+	}
+	if offset < 0 {
+		offset = 0
+	}
+	rows, err := r.db.Query(ctx,
+		`SELECT m.job_id, COALESCE(j.title, ''), COALESCE(j.company, ''), COALESCE(j.location, ''), m.matched_at, m.seen_at
+		 FROM saved_search_matches m
+		 JOIN jobs j ON j.id = m.job_id
+		 WHERE m.saved_search_id = $1 AND (NOT $2 OR m.seen_at IS NULL)
+		 ORDER BY m.matched_at DESC, m.job_id
+		 LIMIT $3 OFFSET $4`,
+		searchID, unseenOnly, limit, offset,
+	)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+
+	out := make([]SavedSearchMatch, 0)
+	for rows.Next() {
+		var it SavedSearchMatch
+		if err := rows.Scan(&it.JobID, &it.Title, &it.CompanyName, &it.Location, &it.MatchedAt, &it.SeenAt); err != nil {
+			return nil, err
+		}
+		out = append(out, it)
+	}
+	if err := rows.Err(); err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (r *PostgresSavedSearchRepository) MarkMatchesSeen(ctx context.Context, searchID uuid.UUID) (int64, error) {
+	return r.db.Exec(ctx,
+		`UPDATE saved_search_matches SET seen_at = now() WHERE saved_search_id = $1 AND seen_at IS NULL`,
+		searchID,
+	)
+}
+
+// InsertMatches stores the jobs and returns only the ones that were not
+// already recorded, so a job is never alerted twice for the same search.
+func (r *PostgresSavedSearchRepository) InsertMatches(ctx context.Context, searchID uuid.UUID, jobIDs []uuid.UUID) ([]uuid.UUID, error) {
+	if len(jobIDs) == 0 {
+		return nil, nil
+	}
+	rows, err := r.db.Query(ctx,
+		`INSERT INTO saved_search_matches (saved_search_id, job_id)
+		 SELECT $1, unnest($2::uuid[])
+		 ON CONFLICT (saved_search_id, job_id) DO NOTHING
+		 RETURNING job_id`,
+		searchID, jobIDs,
+	)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+
+	out := make([]uuid.UUID, 0, len(jobIDs))
+	for rows.Next() {
+		var id uuid.UUID
+		if err := rows.Scan(&id); err != nil {
+			return nil, err
+		}
+		out = append(out, id)
+	}
+	if err := rows.Err(); err != nil {
+		return nil, err
+	}
+
+	if len(out) > 0 {
+		if _, err := r.db.Exec(ctx, `UPDATE saved_searches SET last_matched_at = now() WHERE id = $1`, searchID); err != nil {
+			return nil, err
+		}
+	}
+	return out, nil
+}
+
+func (r *PostgresSavedSearchRepository) Now(ctx context.Context) (time.Time, error) {
+	var now time.Time
+	err := r.db.QueryRow(ctx, `SELECT now()`).Scan(&now)
+	return now, err
+}
+
+func (r *PostgresSavedSearchRepository) AlertCursor(ctx context.Context) (NewJobRef, bool, error) {
+	var c NewJobRef
+	err := r.db.QueryRow(ctx, `SELECT last_created_at, last_job_id FROM saved_search_alert_cursor WHERE id = 1`).Scan(&c.CreatedAt, &c.ID)
+	if err != nil {
+		if errors.Is(err, pgx.ErrNoRows) {
+			return NewJobRef{}, false, nil
+		}
+		return NewJobRef{}, false, err
+	}
+	return c, true, nil
+}
+
+func (r *PostgresSavedSearchRepository) SaveAlertCursor(ctx context.Context, cursor NewJobRef) error {
+	_, err := r.db.Exec(ctx,
+		`INSERT INTO saved_search_alert_cursor (id, last_created_at, last_job_id)
+		 VALUES (1, $1, $2)
+		 ON CONFLICT (id) DO UPDATE SET
+			last_created_at = EXCLUDED.last_created_at,
+			last_job_id = EXCLUDED.last_job_id,
+			updated_at = now()`,
+		cursor.CreatedAt, cursor.ID,
+	)
+	return err
+}
+
+// ListNewJobs pages through jobs created after the cursor. Jobs younger than
+// settle are left for the next run so rows from transactions that are still
+// committing are not skipped.
+func (r *PostgresSavedSearchRepository) ListNewJobs(ctx context.Context, after NewJobRef, settle time.Duration, limit int) ([]NewJobRef, error) {
+	if limit <= 0 {
+		limit = 500
+	}
+	rows, err := r.db.Query(ctx,
+		`SELECT id, created_at
+		 FROM jobs
+		 WHERE created_at IS NOT NULL
+		   AND (created_at, id) > ($1, $2)
+		   AND created_at < now() - make_interval(secs => $3)
+		 ORDER BY created_at, id
+		 LIMIT $4`,
+		after.CreatedAt, after.ID, settle.Seconds(), limit,
+	)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+
+	out := make([]NewJobRef, 0)
+	for rows.Next() {
+		var it NewJobRef
+		if err := rows.Scan(&it.ID, &it.CreatedAt); err != nil {
+			return nil, err
+		}
+		out = append(out, it)
+	}
+	if err := rows.Err(); err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func nonNilStrings(s []string) []string {
+	if s == nil {
+		return []string{}
+	}
+	return s
+}
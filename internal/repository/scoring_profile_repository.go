@@ -0,0 +1,82 @@
+package repository
+
+import (
+	"context"
+
+	"skill-sync/internal/database"
+)
+
+type ScoringProfileRow struct {
+	Name               string
+	Version            int
+	MandatoryWeight    float64
+	OptionalWeight     float64
+	ExperienceWeight   float64
+	ImportanceMode     string
+	PartialCreditCurve string
+	YearsCap           float64
+	RelatedCredit      float64
+	SeniorityPenalty   float64
+	IsDefault          bool
+}
+
+type ScoringProfileRepository interface {
+	ListActive(ctx context.Context) ([]ScoringProfileRow, error)
+}
+
+type PostgresScoringProfileRepository struct {
+	db database.DB
+}
+
+func NewPostgresScoringProfileRepository(db database.DB) *PostgresScoringProfileRepository {
+	return &PostgresScoringProfileRepository{db: db}
+}
+
+func (r *PostgresScoringProfileRepository) ListActive(ctx context.Context) ([]ScoringProfileRow, error) {
+	rows, err := r.db.Query(ctx,
+		`SELECT DISTINCT ON (name)
+			name,
+			version,
+			mandatory_weight::float8,
+			optional_weight::float8,
+			experience_weight::float8,
+			importance_mode,
+			partial_credit_curve,
+			years_cap::float8,
+			related_credit::float8,
+			seniority_penalty::float8,
+			is_default
+		 FROM scoring_profiles
+		 WHERE is_active = true
+		 ORDER BY name ASC, version DESC`,
+	)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+
+	out := make([]ScoringProfileRow, 0)
+	for rows.Next() {
+		var p ScoringProfileRow
+		if err := rows.Scan(
+			&p.Name,
+			&p.Version,
+			&p.MandatoryWeight,
+			&p.OptionalWeight,
+			&p.ExperienceWeight,
+			&p.ImportanceMode,
+			&p.PartialCreditCurve,
+			&p.YearsCap,
+			&p.RelatedCredit,
+			&p.SeniorityPenalty,
+			&p.IsDefault,
+		); err != nil {
+			return nil, err
+		}
+		out = append(out, p)
+	}
+	if err := rows.Err(); err != nil {
+		return nil, err
+	}
+	return out, nil
+}
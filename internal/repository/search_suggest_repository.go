@@ -0,0 +1,142 @@
+package repository
+
+import (
+	"context"
+	"time"
+
+	"skill-sync/internal/database"
+)
+
+type SuggestTermRow struct {
+	Type  string
+	Text  string
+	Count int
+}
+
+// CatalogueVersion changes when active jobs are added or removed.
+type CatalogueVersion struct {
+	ActiveJobs    int
+	LastCreatedAt time.Time
+}
+
+type SearchSuggestRepository interface {
+	ListSuggestTerms(ctx context.Context) ([]SuggestTermRow, error)
+	ListQueryPopularity(ctx context.Context, limit int) (map[string]int, error)
+	IncrementQueryCounts(ctx context.Context, counts map[string]int) error
+	CatalogueVersion(ctx context.Context) (CatalogueVersion, error)
+}
+
+type PostgresSearchSuggestRepository struct {
+	db database.DB
+}
+
+func NewPostgresSearchSuggestRepository(db database.DB) *PostgresSearchSuggestRepository {
+	return &PostgresSearchSuggestRepository{db: db}
+}
+
+// ListSuggestTerms counts titles, companies and locations by case-insensitive
+// value and skills by the jobs requiring them, over active jobs only.
+func (r *PostgresSearchSuggestRepository) ListSuggestTerms(ctx context.Context) ([]SuggestTermRow, error) {
+	rows, err := r.db.Query(ctx,
+		`SELECT 'title', MIN(btrim(j.title)), COUNT(*)::int
+		 FROM jobs j
+		 WHERE j.is_active = true AND btrim(COALESCE(j.title, '')) <> ''
+		 GROUP BY lower(btrim(j.title))
+		 UNION ALL
+		 SELECT 'company', MIN(btrim(j.company)), COUNT(*)::int
+		 FROM jobs j
+		 WHERE j.is_active = true AND btrim(COALESCE(j.company, '')) <> ''
+		 GROUP BY lower(btrim(j.company))
+		 UNION ALL
+		 SELECT 'location', MIN(btrim(j.location)), COUNT(*)::int
+		 FROM jobs j
+		 WHERE j.is_active = true AND btrim(COALESCE(j.location, '')) <> ''
+		 GROUP BY lower(btrim(j.location))
+		 UNION ALL
+		 SELECT 'skill', MIN(s.name), COUNT(DISTINCT js.job_id)::int
+		 FROM job_skills js
+		 JOIN jobs j ON j.id = js.job_id AND j.is_active = true
+		 JOIN skills s ON s.id = js.skill_id
+		 GROUP BY lower(s.name)`,
+	)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+
+	out := make([]SuggestTermRow, 0)
+	for rows.Next() {
+		var it SuggestTermRow
+		if err := rows.Scan(&it.Type, &it.Text, &it.Count); err != nil {
+			return nil, err
+		}
+		out = append(out, it)
+	}
+	if err := rows.Err(); err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (r *PostgresSearchSuggestRepository) ListQueryPopularity(ctx context.Context, limit int) (map[string]int, error) {
+	if limit <= 0 {
+		limit = 10000
+	}
+	rows, err := r.db.Query(ctx,
+		`SELECT query, LEAST(search_count, 2147483647)::int
+		 FROM search_query_stats
+		 ORDER BY search_count DESC
+		 LIMIT $1`,
+		limit,
+	)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+
+	out := make(map[string]int)
+	for rows.Next() {
+		var q string
+		var n int
+		if err := rows.Scan(&q, &n); err != nil {
+			return nil, err
+		}
+		out[q] = n
+	}
+	if err := rows.Err(); err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (r *PostgresSearchSuggestRepository) IncrementQueryCounts(ctx context.Context, counts map[string]int) error {
+	if len(counts) == 0 {
+		return nil
+	}
+	queries := make([]string, 0, len(counts))
+	deltas := make([]int64, 0, len(counts))
+	for q, n := range counts {
+		queries = append(queries, q)
+		deltas = append(deltas, int64(n))
+	}
+	_, err := r.db.Exec(ctx,
+		`INSERT INTO search_query_stats (query, search_count)
+		 SELECT q, n FROM unnest($1::text[], $2::bigint[]) AS t(q, n)
+		 ON CONFLICT (query) DO UPDATE SET
+			search_count = search_query_stats.search_count + EXCLUDED.search_count,
+			last_searched_at = now()`,
+		queries,
+		deltas,
+	)
+	return err
+}
+
+func (r *PostgresSearchSuggestRepository) CatalogueVersion(ctx context.Context) (CatalogueVersion, error) {
+	var v CatalogueVersion
+	err := r.db.QueryRow(ctx,
+		`SELECT COUNT(*)::int, COALESCE(MAX(created_at), 'epoch'::timestamptz)
+		 FROM jobs
+		 WHERE is_active = true`,
+	).Scan(&v.ActiveJobs, &v.LastCreatedAt)
+	return v, err
+}
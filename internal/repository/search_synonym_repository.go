@@ -0,0 +1,159 @@
+package repository
+
+import (
+	"context"
+	"errors"
+	"time"
+
+	"skill-sync/internal/database"
+
+	"github.com/google/uuid"
+	"github.com/jackc/pgx/v5"
+)
+
+type SearchSynonym struct {
+	ID        uuid.UUID
+	Language  string
+	Term      string
+	Synonyms  []string
+	TwoWay    bool
+	CreatedAt time.Time
+	UpdatedAt time.Time
+}
+
+type SearchSynonymInput struct {
+	Language string
+	Term     string
+	Synonyms []string
+	TwoWay   bool
+}
+
+// SynonymVersion changes whenever a row is added, edited or removed; it lets
+// other instances notice an edit without reloading the whole table.
+type SynonymVersion struct {
+	Count     int
+	UpdatedAt time.Time
+}
+
+type SearchSynonymRepository interface {
+	ListAll(ctx context.Context) ([]SearchSynonym, error)
+	FindByID(ctx context.Context, id uuid.UUID) (SearchSynonym, bool, error)
+	Create(ctx context.Context, in SearchSynonymInput) (SearchSynonym, error)
+	Update(ctx context.Context, id uuid.UUID, in SearchSynonymInput) (SearchSynonym, bool, error)
+	Delete(ctx context.Context, id uuid.UUID) (SearchSynonym, bool, error)
+	Version(ctx context.Context) (SynonymVersion, error)
+}
+
+type PostgresSearchSynonymRepository struct {
+	db database.DB
+}
+
+func NewPostgresSearchSynonymRepository(db database.DB) *PostgresSearchSynonymRepository {
+	return &PostgresSearchSynonymRepository{db: db}
+}
+
+const searchSynonymColumns = `id, language, term, synonyms, two_way, created_at, updated_at`
+
+func scanSearchSynonym(row database.Row) (SearchSynonym, error) {
+	var it SearchSynonym
+	if err := row.Scan(&it.ID, &it.Language, &it.Term, &it.Synonyms, &it.TwoWay, &it.CreatedAt, &it.UpdatedAt); err != nil {
+		return SearchSynonym{}, err
+	}
+	it.Synonyms = nonNilStrings(it.Synonyms)
+	return it, nil
+}
+
+func (r *PostgresSearchSynonymRepository) ListAll(ctx context.Context) ([]SearchSynonym, error) {
+	rows, err := r.db.Query(ctx,
+		`SELECT `+searchSynonymColumns+`
+		 FROM search_synonyms
+		 ORDER BY language ASC, term ASC`,
+	)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+
+	out := make([]SearchSynonym, 0)
+	for rows.Next() {
+		it, err := scanSearchSynonym(rows)
+		if err != nil {
+			return nil, err
+		}
+		out = append(out, it)
+	}
+	if err := rows.Err(); err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (r *PostgresSearchSynonymRepository) FindByID(ctx context.Context, id uuid.UUID) (SearchSynonym, bool, error) {
+	it, err := scanSearchSynonym(r.db.QueryRow(ctx,
+		`SELECT `+searchSynonymColumns+` FROM search_synonyms WHERE id = $1`,
+		id,
+	))
+	if err != nil {
+		if errors.Is(err, pgx.ErrNoRows) {
+			return SearchSynonym{}, false, nil
+		}
+		return SearchSynonym{}, false, err
+	}
+	return it, true, nil
+}
+
+func (r *PostgresSearchSynonymRepository) Create(ctx context.Context, in SearchSynonymInput) (SearchSynonym, error) {
+	return scanSearchSynonym(r.db.QueryRow(ctx,
+		`INSERT INTO search_synonyms (id, language, term, synonyms, two_way)
+		 VALUES ($1,$2,$3,$4,$5)
+		 RETURNING `+searchSynonymColumns,
+		uuid.New(),
+		in.Language,
+		in.Term,
+		nonNilStrings(in.Synonyms),
+		in.TwoWay,
+	))
+}
+
+func (r *PostgresSearchSynonymRepository) Update(ctx context.Context, id uuid.UUID, in SearchSynonymInput) (SearchSynonym, bool, error) {
+	it, err := scanSearchSynonym(r.db.QueryRow(ctx,
+		`UPDATE search_synonyms
+		 SET language = $2, term = $3, synonyms = $4, two_way = $5, updated_at = now()
+		 WHERE id = $1
+		 RETURNING `+searchSynonymColumns,
+		id,
+		in.Language,
+		in.Term,
+		nonNilStrings(in.Synonyms),
+		in.TwoWay,
+	))
+	if err != nil {
+		if errors.Is(err, pgx.ErrNoRows) {
+			return SearchSynonym{}, false, nil
+		}
+		return SearchSynonym{}, false, err
+	}
+	return it, true, nil
+}
+
+func (r *PostgresSearchSynonymRepository) Delete(ctx context.Context, id uuid.UUID) (SearchSynonym, bool, error) {
+	it, err := scanSearchSynonym(r.db.QueryRow(ctx,
+		`DELETE FROM search_synonyms WHERE id = $1 RETURNING `+searchSynonymColumns,
+		id,
+	))
+	if err != nil {
+		if errors.Is(err, pgx.ErrNoRows) {
+			return SearchSynonym{}, false, nil
+		}
+		return SearchSynonym{}, false, err
+	}
+	return it, true, nil
+}
+
+func (r *PostgresSearchSynonymRepository) Version(ctx context.Context) (SynonymVersion, error) {
+	var v SynonymVersion
+	err := r.db.QueryRow(ctx,
+		`SELECT COUNT(*)::int, COALESCE(MAX(updated_at), 'epoch'::timestamptz) FROM search_synonyms`,
+	).Scan(&v.Count, &v.UpdatedAt)
+	return v, err
+}
@@ -0,0 +1,62 @@
+package repository
+
+import (
+	"context"
+
+	"skill-sync/internal/database"
+)
+
+type SearchVocabularyRepository interface {
+	// ListVocabulary returns the words of active job titles with how often
+	// each appears; skill names and synonym terms are counted with extra
+	// weight since they are curated.
+	ListVocabulary(ctx context.Context) (map[string]int, error)
+}
+
+type PostgresSearchVocabularyRepository struct {
+	db database.DB
+}
+
+func NewPostgresSearchVocabularyRepository(db database.DB) *PostgresSearchVocabularyRepository {
+	return &PostgresSearchVocabularyRepository{db: db}
+}
+
+const curatedVocabularyWeight = 5
+
+func (r *PostgresSearchVocabularyRepository) ListVocabulary(ctx context.Context) (map[string]int, error) {
+	rows, err := r.db.Query(ctx,
+		`SELECT w, SUM(n)::int
+		 FROM (
+			SELECT regexp_split_to_table(lower(j.title), '[^[:alnum:]]+') AS w, 1 AS n
+			FROM jobs j
+			WHERE j.is_active = true AND j.title IS NOT NULL
+			UNION ALL
+			SELECT regexp_split_to_table(lower(s.name), '[^[:alnum:]]+'), $1
+			FROM skills s
+			UNION ALL
+			SELECT regexp_split_to_table(lower(t), '[^[:alnum:]]+'), $1
+			FROM search_synonyms ss, unnest(array_prepend(ss.term, ss.synonyms)) AS t
+		 ) v
+		 WHERE length(w) >= 2
+		 GROUP BY w`,
+		curatedVocabularyWeight,
+	)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+
+	out := make(map[string]int)
+	for rows.Next() {
+		var w string
+		var n int
+		if err := rows.Scan(&w, &n); err != nil {
+			return nil, err
+		}
+		out[w] = n
+	}
+	if err := rows.Err(); err != nil {
+		return nil, err
+	}
+	return out, nil
+}
@@ -0,0 +1,165 @@
+package repository
+
+import (
+	"context"
+	"database/sql"
+	"errors"
+
+	"skill-sync/internal/database"
+
+	"github.com/google/uuid"
+	"github.com/jackc/pgx/v5"
+)
+
+type JobForSeniority struct {
+	ID          uuid.UUID
+	Title       string
+	Description string
+}
+
+type SeniorityRepository interface {
+	UserExperienceLevel(ctx context.Context, userID uuid.UUID) (string, error)
+	ListUserExperienceLevels(ctx context.Context) (map[uuid.UUID]string, error)
+	JobSeniority(ctx context.Context, jobID uuid.UUID) (string, error)
+	ListJobSeniorities(ctx context.Context, jobIDs []uuid.UUID) (map[uuid.UUID]string, error)
+	ListActiveJobSeniorities(ctx context.Context) (map[uuid.UUID]string, error)
+	ListJobsForSeniority(ctx context.Context, afterID uuid.UUID, onlyMissing bool, limit int) ([]JobForSeniority, error)
+	UpdateJobSeniority(ctx context.Context, jobID uuid.UUID, level string) error
+}
+
+type PostgresSeniorityRepository struct {
+	db database.DB
+}
+
+func NewPostgresSeniorityRepository(db database.DB) *PostgresSeniorityRepository {
+	return &PostgresSeniorityRepository{db: db}
+}
+
+// UserExperienceLevel returns the raw experience_level text of a profile, or
+// "" when the user has no profile yet.
+func (r *PostgresSeniorityRepository) UserExperienceLevel(ctx context.Context, userID uuid.UUID) (string, error) {
+	var level string
+	row := r.db.QueryRow(ctx, `SELECT COALESCE(experience_level, '') FROM user_profiles WHERE user_id = $1`, userID)
+	if err := row.Scan(&level); err != nil {
+		if err == sql.ErrNoRows || errors.Is(err, pgx.ErrNoRows) {
+			return "", nil
+		}
+		return "", err
+	}
+	return level, nil
+}
+
+func (r *PostgresSeniorityRepository) ListUserExperienceLevels(ctx context.Context) (map[uuid.UUID]string, error) {
+	rows, err := r.db.Query(ctx,
+		`SELECT user_id, experience_level
+		 FROM user_profiles
+		 WHERE user_id IS NOT NULL AND COALESCE(experience_level, '') <> ''`,
+	)
+	if err != nil {
+		return nil, err
+	}
+	return scanIDTextMap(rows)
+}
+
+func (r *PostgresSeniorityRepository) JobSeniority(ctx context.Context, jobID uuid.UUID) (string, error) {
+	var level string
+	row := r.db.QueryRow(ctx, `SELECT COALESCE(seniority, '') FROM jobs WHERE id = $1`, jobID)
+	if err := row.Scan(&level); err != nil {
+		if err == sql.ErrNoRows || errors.Is(err, pgx.ErrNoRows) {
+			return "", nil
+		}
+		return "", err
+	}
+	return level, nil
+}
+
+func (r *PostgresSeniorityRepository) ListJobSeniorities(ctx context.Context, jobIDs []uuid.UUID) (map[uuid.UUID]string, error) {
+	if len(jobIDs) == 0 {
+		return map[uuid.UUID]string{}, nil
+	}
+	rows, err := r.db.Query(ctx,
+		`SELECT id, seniority FROM jobs WHERE id = ANY($1) AND seniority IS NOT NULL`,
+		jobIDs,
+	)
+	if err != nil {
+		return nil, err
+	}
+	return scanIDTextMap(rows)
+}
+
+func (r *PostgresSeniorityRepository) ListActiveJobSeniorities(ctx context.Context) (map[uuid.UUID]string, error) {
+	rows, err := r.db.Query(ctx,
+		`SELECT id, seniority FROM jobs WHERE is_active = true AND seniority IS NOT NULL`,
+	)
+	if err != nil {
+		return nil, err
+	}
+	return scanIDTextMap(rows)
+}
+
+// ListJobsForSeniority pages through jobs by id for backfilling.
+func (r *PostgresSeniorityRepository) ListJobsForSeniority(ctx context.Context, afterID uuid.UUID, onlyMissing bool, limit int) ([]JobForSeniority, error) {
+	if limit <= 0 {
+		limit = 500
+	}
+	rows, err := r.db.Query(ctx,
+		`SELECT id, COALESCE(title, ''), COALESCE(description, raw_description, '')
+		 FROM jobs
+		 WHERE id > $1 AND ($2 = false OR seniority IS NULL)
+		 ORDER BY id ASC
+		 LIMIT $3`,
+		afterID, onlyMissing, limit,
+	)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+
+	out := make([]JobForSeniority, 0)
+	for rows.Next() {
+		var it JobForSeniority
+		if err := rows.Scan(&it.ID, &it.Title, &it.Description); err != nil {
+			return nil, err
+		}
+		out = append(out, it)
+	}
+	if err := rows.Err(); err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+// UpdateJobSeniority stores the level and marks the job for re-matching when
+// it actually changed.
+func (r *PostgresSeniorityRepository) UpdateJobSeniority(ctx context.Context, jobID uuid.UUID, level string) error {
+	tag, err := r.db.Exec(ctx,
+		`UPDATE jobs SET seniority = $2 WHERE id = $1 AND seniority IS DISTINCT FROM $2`,
+		jobID, nullableText(level),
+	)
+	if err != nil {
+		return err
+	}
+	if tag == 0 {
+		return nil
+	}
+	_, err = r.db.Exec(ctx, markJobsDirtySQL, []uuid.UUID{jobID})
+	return err
+}
+
+func scanIDTextMap(rows database.Rows) (map[uuid.UUID]string, error) {
+	defer rows.Close()
+
+	out := make(map[uuid.UUID]string)
+	for rows.Next() {
+		var id uuid.UUID
+		var v string
+		if err := rows.Scan(&id, &v); err != nil {
+			return nil, err
+		}
+		out[id] = v
+	}
+	if err := rows.Err(); err != nil {
+		return nil, err
+	}
+	return out, nil
+}
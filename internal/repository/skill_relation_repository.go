@@ -0,0 +1,106 @@
+package repository
+
+import (
+	"context"
+	"time"
+
+	"skill-sync/internal/database"
+
+	"github.com/google/uuid"
+)
+
+type SkillRelation struct {
+	ID               uuid.UUID
+	SkillID          uuid.UUID
+	SkillName        string
+	RelatedSkillID   uuid.UUID
+	RelatedSkillName string
+	RelationType     string
+	Credit           float64
+	CreatedAt        time.Time
+	UpdatedAt        time.Time
+}
+
+type SkillRelationUpsert struct {
+	SkillID        uuid.UUID
+	RelatedSkillID uuid.UUID
+	RelationType   string
+	Credit         float64
+}
+
+type SkillRelationRepository interface {
+	ListAll(ctx context.Context) ([]SkillRelation, error)
+	Upsert(ctx context.Context, in SkillRelationUpsert) (SkillRelation, error)
+	Delete(ctx context.Context, id uuid.UUID) (bool, error)
+}
+
+type PostgresSkillRelationRepository struct {
+	db database.DB
+}
+
+func NewPostgresSkillRelationRepository(db database.DB) *PostgresSkillRelationRepository {
+	return &PostgresSkillRelationRepository{db: db}
+}
+
+func (r *PostgresSkillRelationRepository) ListAll(ctx context.Context) ([]SkillRelation, error) {
+	rows, err := r.db.Query(ctx,
+		`SELECT sr.id, sr.skill_id, s.name, sr.related_skill_id, rs.name, sr.relation_type, sr.credit::float8, sr.created_at, sr.updated_at
+		 FROM skill_relations sr
+		 JOIN skills s ON s.id = sr.skill_id
+		 JOIN skills rs ON rs.id = sr.related_skill_id
+		 ORDER BY s.name ASC, rs.name ASC`,
+	)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+
+	out := make([]SkillRelation, 0)
+	for rows.Next() {
+		var it SkillRelation
+		if err := rows.Scan(&it.ID, &it.SkillID, &it.SkillName, &it.RelatedSkillID, &it.RelatedSkillName, &it.RelationType, &it.Credit, &it.CreatedAt, &it.UpdatedAt); err != nil {
+			return nil, err
+		}
+		out = append(out, it)
+	}
+	if err := rows.Err(); err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (r *PostgresSkillRelationRepository) Upsert(ctx context.Context, in SkillRelationUpsert) (SkillRelation, error) {
+	row := r.db.QueryRow(ctx,
+		`WITH up AS (
+			INSERT INTO skill_relations (id, skill_id, related_skill_id, relation_type, credit)
+			VALUES ($1,$2,$3,$4,$5)
+			ON CONFLICT (skill_id, related_skill_id, relation_type) DO UPDATE SET
+				credit = EXCLUDED.credit,
+				updated_at = now()
+			RETURNING id, skill_id, related_skill_id, relation_type, credit, created_at, updated_at
+		)
+		SELECT up.id, up.skill_id, s.name, up.related_skill_id, rs.name, up.relation_type, up.credit::float8, up.created_at, up.updated_at
+		FROM up
+		JOIN skills s ON s.id = up.skill_id
+		JOIN skills rs ON rs.id = up.related_skill_id`,
+		uuid.New(),
+		in.SkillID,
+		in.RelatedSkillID,
+		in.RelationType,
+		in.Credit,
+	)
+
+	var it SkillRelation
+	if err := row.Scan(&it.ID, &it.SkillID, &it.SkillName, &it.RelatedSkillID, &it.RelatedSkillName, &it.RelationType, &it.Credit, &it.CreatedAt, &it.UpdatedAt); err != nil {
+		return SkillRelation{}, err
+	}
+	return it, nil
+}
+
+func (r *PostgresSkillRelationRepository) Delete(ctx context.Context, id uuid.UUID) (bool, error) {
+	affected, err := r.db.Exec(ctx, `DELETE FROM skill_relations WHERE id = $1`, id)
+	if err != nil {
+		return false, err
+	}
+	return affected > 0, nil
+}
@@ -0,0 +1,251 @@
+package repository
+
+import (
+	"context"
+	"strings"
+	"time"
+
+	"skill-sync/internal/database"
+
+	"github.com/google/uuid"
+)
+
+type DismissedJob struct {
+	JobID     uuid.UUID
+	Title     string
+	Company   string
+	Location  string
+	Reason    string
+	Note      string
+	CreatedAt time.Time
+}
+
+type BlockedCompany struct {
+	ID          uuid.UUID
+	CompanyName string
+	CreatedAt   time.Time
+}
+
+type DismissalReasonCount struct {
+	Reason string
+	Count  int
+}
+
+// UserExclusions lists what a user asked not to be shown again.
+type UserExclusions struct {
+	JobIDs      []uuid.UUID
+	CompanyKeys []string
+}
+
+func (e UserExclusions) Empty() bool {
+	return len(e.JobIDs) == 0 && len(e.CompanyKeys) == 0
+}
+
+// Excludes reports whether a job with the given id and company is hidden.
+func (e UserExclusions) Excludes(jobID uuid.UUID, company string) bool {
+	for _, id := range e.JobIDs {
+		if id == jobID {
+			return true
+		}
+	}
+	key := CompanyKey(company)
+	if key == "" {
+		return false
+	}
+	for _, k := range e.CompanyKeys {
+		if k == key {
+			return true
+		}
+	}
+	return false
+}
+
+type UserFeedbackRepository interface {
+	DismissJob(ctx context.Context, userID, jobID uuid.UUID, reason, note string) error
+	UndismissJob(ctx context.Context, userID, jobID uuid.UUID) (bool, error)
+	ListDismissedJobs(ctx context.Context, userID uuid.UUID) ([]DismissedJob, error)
+	BlockCompany(ctx context.Context, userID uuid.UUID, companyName string) (BlockedCompany, error)
+	UnblockCompany(ctx context.Context, userID, id uuid.UUID) (bool, error)
+	ListBlockedCompanies(ctx context.Context, userID uuid.UUID) ([]BlockedCompany, error)
+	ListExclusions(ctx context.Context, userID uuid.UUID) (UserExclusions, error)
+	CountDismissalReasons(ctx context.Context, since time.Time) ([]DismissalReasonCount, error)
+}
+
+type PostgresUserFeedbackRepository struct {
+	db database.DB
+}
+
+func NewPostgresUserFeedbackRepository(db database.DB) *PostgresUserFeedbackRepository {
+	return &PostgresUserFeedbackRepository{db: db}
+}
+
+// CompanyKey normalizes a company name the same way the jobs index does.
+func CompanyKey(name string) string {
+	return strings.ToLower(strings.TrimSpace(name))
+}
+
+func (r *PostgresUserFeedbackRepository) DismissJob(ctx context.Context, userID, jobID uuid.UUID, reason, note string) error {
+	_, err := r.db.Exec(ctx,
+		`INSERT INTO user_dismissed_jobs (user_id, job_id, reason, note)
+		 VALUES ($1,$2,$3,$4)
+		 ON CONFLICT (user_id, job_id) DO UPDATE SET
+			reason = EXCLUDED.reason,
+			note = EXCLUDED.note,
+			created_at = now()`,
+		userID, jobID, reason, nullableText(note),
+	)
+	return err
+}
+
+func (r *PostgresUserFeedbackRepository) UndismissJob(ctx context.Context, userID, jobID uuid.UUID) (bool, error) {
+	n, err := r.db.Exec(ctx, `DELETE FROM user_dismissed_jobs WHERE user_id = $1 AND job_id = $2`, userID, jobID)
+	if err != nil {
+		return false, err
+	}
+	return n > 0, nil
+}
+
+func (r *PostgresUserFeedbackRepository) ListDismissedJobs(ctx context.Context, userID uuid.UUID) ([]DismissedJob, error) {
+	rows, err := r.db.Query(ctx,
+		`SELECT d.job_id,
+		        COALESCE(j.title, ''),
+		        COALESCE(j.company, ''),
+		        COALESCE(j.location, ''),
+		        d.reason,
+		        COALESCE(d.note, ''),
+		        d.created_at
+		 FROM user_dismissed_jobs d
+		 JOIN jobs j ON j.id = d.job_id
+		 WHERE d.user_id = $1
+		 ORDER BY d.created_at DESC`,
+		userID,
+	)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+
+	out := make([]DismissedJob, 0)
+	for rows.Next() {
+		var it DismissedJob
+		if err := rows.Scan(&it.JobID, &it.Title, &it.Company, &it.Location, &it.Reason, &it.Note, &it.CreatedAt); err != nil {
+			return nil, err
+		}
+		out = append(out, it)
+	}
+	if err := rows.Err(); err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (r *PostgresUserFeedbackRepository) BlockCompany(ctx context.Context, userID uuid.UUID, companyName string) (BlockedCompany, error) {
+	companyName = strings.TrimSpace(companyName)
+	var it BlockedCompany
+	row := r.db.QueryRow(ctx,
+		`INSERT INTO user_blocked_companies (id, user_id, company_name, company_key)
+		 VALUES ($1,$2,$3,$4)
+		 ON CONFLICT (user_id, company_key) DO UPDATE SET company_name = EXCLUDED.company_name
+		 RETURNING id, company_name, created_at`,
+		uuid.New(), userID, companyName, CompanyKey(companyName),
+	)
+	if err := row.Scan(&it.ID, &it.CompanyName, &it.CreatedAt); err != nil {
+		return BlockedCompany{}, err
+	}
+	return it, nil
+}
+
+func (r *PostgresUserFeedbackRepository) UnblockCompany(ctx context.Context, userID, id uuid.UUID) (bool, error) {
+	n, err := r.db.Exec(ctx, `DELETE FROM user_blocked_companies WHERE user_id = $1 AND id = $2`, userID, id)
+	if err != nil {
+		return false, err
+	}
+	return n > 0, nil
+}
+
+func (r *PostgresUserFeedbackRepository) ListBlockedCompanies(ctx context.Context, userID uuid.UUID) ([]BlockedCompany, error) {
+	rows, err := r.db.Query(ctx,
+		`SELECT id, company_name, created_at
+		 FROM user_blocked_companies
+		 WHERE user_id = $1
+		 ORDER BY company_name ASC`,
+		userID,
+	)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+
+	out := make([]BlockedCompany, 0)
+	for rows.Next() {
+		var it BlockedCompany
+		if err := rows.Scan(&it.ID, &it.CompanyName, &it.CreatedAt); err != nil {
+			return nil, err
+		}
+		out = append(out, it)
+	}
+	if err := rows.Err(); err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (r *PostgresUserFeedbackRepository) ListExclusions(ctx context.Context, userID uuid.UUID) (UserExclusions, error) {
+	rows, err := r.db.Query(ctx,
+		`SELECT job_id::text, 'job' FROM user_dismissed_jobs WHERE user_id = $1
+		 UNION ALL
+		 SELECT company_key, 'company' FROM user_blocked_companies WHERE user_id = $1`,
+		userID,
+	)
+	if err != nil {
+		return UserExclusions{}, err
+	}
+	defer rows.Close()
+
+	var out UserExclusions
+	for rows.Next() {
+		var value, kind string
+		if err := rows.Scan(&value, &kind); err != nil {
+			return UserExclusions{}, err
+		}
+		if kind == "company" {
+			out.CompanyKeys = append(out.CompanyKeys, value)
+			continue
+		}
+		if id, err := uuid.Parse(value); err == nil {
+			out.JobIDs = append(out.JobIDs, id)
+		}
+	}
+	if err := rows.Err(); err != nil {
+		return UserExclusions{}, err
+	}
+	return out, nil
+}
+
+func (r *PostgresUserFeedbackRepository) CountDismissalReasons(ctx context.Context, since time.Time) ([]DismissalReasonCount, error) {
+	rows, err := r.db.Query(ctx,
+		`SELECT reason, COUNT(*)
+		 FROM user_dismissed_jobs
+		 WHERE created_at >= $1
+		 GROUP BY reason
+		 ORDER BY COUNT(*) DESC, reason ASC`,
+		since,
+	)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+
+	out := make([]DismissalReasonCount, 0)
+	for rows.Next() {
+		var it DismissalReasonCount
+		if err := rows.Scan(&it.Reason, &it.Count); err != nil {
+			return nil, err
+		}
+		out = append(out, it)
+	}
+	if err := rows.Err(); err != nil {
+		return nil, err
+	}
+	return out, nil
+}
@@ -10,6 +10,7 @@ import (
 
 type UserQueryRepository interface {
 	ListUserIDs(ctx context.Context, limit, offset int) ([]uuid.UUID, error)
+	ListAllUserSkills(ctx context.Context) (map[uuid.UUID][]UserSkill, error)
 }
 
 type PostgresUserQueryRepository struct {
@@ -56,3 +57,29 @@ func (r *PostgresUserQueryRepository) ListUserIDs(ctx context.Context, limit, of
 	}
 	return out, nil
 }
+
+func (r *PostgresUserQueryRepository) ListAllUserSkills(ctx context.Context) (map[uuid.UUID][]UserSkill, error) {
+	rows, err := r.db.Query(ctx,
+		`SELECT us.id, us.user_id, us.skill_id, s.name, COALESCE(us.proficiency_level, 0), COALESCE(us.years_experience, 0)
+		 FROM user_skills us
+		 JOIN skills s ON s.id = us.skill_id
+		 ORDER BY us.user_id ASC, s.name ASC`,
+	)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+
+	out := make(map[uuid.UUID][]UserSkill)
+	for rows.Next() {
+		var us UserSkill
+		if err := rows.Scan(&us.ID, &us.UserID, &us.SkillID, &us.SkillName, &us.ProficiencyLevel, &us.YearsExperience); err != nil {
+			return nil, err
+		}
+		out[us.UserID] = append(out[us.UserID], us)
+	}
+	if err := rows.Err(); err != nil {
+		return nil, err
+	}
+	return out, nil
+}
@@ -10,6 +10,7 @@ import (
 	"time"
 
 	"skill-sync/internal/database"
+	"skill-sync/internal/domain/job"
 
 	"github.com/google/uuid"
 )
@@ -134,14 +135,22 @@ func insertRawJob(ctx context.Context, db database.DB, sourceID uuid.UUID, runID
 		externalID = stableExternalIDFromURL(in.URL)
 	}
 	url := strings.TrimSpace(in.URL)
+	seniority := job.ClassifySeniority(in.Title, in.Description)
+	locationID := job.LocationID(in.Location)
+	salaryText := in.Description
+	if strings.TrimSpace(salaryText) == "" {
+		salaryText = in.RawDescription
+	}
+	salary, _ := job.JobSalary(in.Title, salaryText)
 
 	var err error
 	if url != "" {
 		_, err = db.Exec(ctx,
 			`INSERT INTO jobs (
 				id, source_id, external_job_id, title, company, location, employment_type,
-				description, raw_description, posted_at, scraped_at, url, source_url, is_active
-			) VALUES ($1,$2,$3,$4,$5,$6,$7,$8,$9,$10,$11,$12,$13,$14)
+				description, raw_description, posted_at, scraped_at, url, source_url, is_active, seniority, location_id,
+				salary_min, salary_max, salary_currency, salary_period
+			) VALUES ($1,$2,$3,$4,$5,$6,$7,$8,$9,$10,$11,$12,$13,$14,$15,$16,$17,$18,$19,$20)
 			ON CONFLICT (source_id, url) DO UPDATE SET
 				external_job_id = COALESCE(EXCLUDED.external_job_id, jobs.external_job_id),
 				title = COALESCE(EXCLUDED.title, jobs.title),
@@ -153,7 +162,13 @@ func insertRawJob(ctx context.Context, db database.DB, sourceID uuid.UUID, runID
 				posted_at = COALESCE(EXCLUDED.posted_at, jobs.posted_at),
 				scraped_at = COALESCE(EXCLUDED.scraped_at, jobs.scraped_at),
 				source_url = COALESCE(EXCLUDED.source_url, jobs.source_url),
-				is_active = EXCLUDED.is_active`,
+				is_active = EXCLUDED.is_active,
+				seniority = COALESCE(EXCLUDED.seniority, jobs.seniority),
+				location_id = CASE WHEN EXCLUDED.location IS NULL THEN jobs.location_id ELSE EXCLUDED.location_id END,
+				salary_min = CASE WHEN EXCLUDED.description IS NULL THEN jobs.salary_min ELSE EXCLUDED.salary_min END,
+				salary_max = CASE WHEN EXCLUDED.description IS NULL THEN jobs.salary_max ELSE EXCLUDED.salary_max END,
+				salary_currency = CASE WHEN EXCLUDED.description IS NULL THEN jobs.salary_currency ELSE EXCLUDED.salary_currency END,
+				salary_period = CASE WHEN EXCLUDED.description IS NULL THEN jobs.salary_period ELSE EXCLUDED.salary_period END`,
 			uuid.New(),
 			sourceID,
 			nullableText(externalID),
@@ -168,13 +183,20 @@ func insertRawJob(ctx context.Context, db database.DB, sourceID uuid.UUID, runID
 			nullableText(url),
 			nullableText(url),
 			in.IsActive,
+			nullableText(seniority),
+			nullableText(locationID),
+			nullableInt(salary.Min),
+			nullableInt(salary.Max),
+			nullableText(salary.Currency),
+			nullableText(salary.Period),
 		)
 	} else {
 		_, err = db.Exec(ctx,
 			`INSERT INTO jobs (
 				id, source_id, external_job_id, title, company, location, employment_type,
-				description, raw_description, posted_at, scraped_at, url, source_url, is_active
-			) VALUES ($1,$2,$3,$4,$5,$6,$7,$8,$9,$10,$11,$12,$13,$14)
+				description, raw_description, posted_at, scraped_at, url, source_url, is_active, seniority, location_id,
+				salary_min, salary_max, salary_currency, salary_period
+			) VALUES ($1,$2,$3,$4,$5,$6,$7,$8,$9,$10,$11,$12,$13,$14,$15,$16,$17,$18,$19,$20)
 			ON CONFLICT (source_id, external_job_id) DO UPDATE SET
 				title = COALESCE(EXCLUDED.title, jobs.title),
 				company = COALESCE(EXCLUDED.company, jobs.company),
@@ -185,7 +207,13 @@ func insertRawJob(ctx context.Context, db database.DB, sourceID uuid.UUID, runID
 				posted_at = COALESCE(EXCLUDED.posted_at, jobs.posted_at),
 				scraped_at = COALESCE(EXCLUDED.scraped_at, jobs.scraped_at),
 				source_url = COALESCE(EXCLUDED.source_url, jobs.source_url),
-				is_active = EXCLUDED.is_active`,
+				is_active = EXCLUDED.is_active,
+				seniority = COALESCE(EXCLUDED.seniority, jobs.seniority),
+				location_id = CASE WHEN EXCLUDED.location IS NULL THEN jobs.location_id ELSE EXCLUDED.location_id END,
+				salary_min = CASE WHEN EXCLUDED.description IS NULL THEN jobs.salary_min ELSE EXCLUDED.salary_min END,
+				salary_max = CASE WHEN EXCLUDED.description IS NULL THEN jobs.salary_max ELSE EXCLUDED.salary_max END,
+				salary_currency = CASE WHEN EXCLUDED.description IS NULL THEN jobs.salary_currency ELSE EXCLUDED.salary_currency END,
+				salary_period = CASE WHEN EXCLUDED.description IS NULL THEN jobs.salary_period ELSE EXCLUDED.salary_period END`,
 			uuid.New(),
 			sourceID,
 			nullableText(externalID),
@@ -200,6 +228,12 @@ func insertRawJob(ctx context.Context, db database.DB, sourceID uuid.UUID, runID
 			nullableText(url),
 			nullableText(url),
 			in.IsActive,
+			nullableText(seniority),
+			nullableText(locationID),
+			nullableInt(salary.Min),
+			nullableInt(salary.Max),
+			nullableText(salary.Currency),
+			nullableText(salary.Period),
 		)
 	}
 	if err != nil {
@@ -228,6 +262,13 @@ func nullableText(s string) any {
 	return s
 }
 
+func nullableInt(v int) any {
+	if v <= 0 {
+		return nil
+	}
+	return v
+}
+
 func readAllLimit(r io.Reader, max int64) ([]byte, error) {
 	lr := &io.LimitedReader{R: r, N: max}
 	b, err := io.ReadAll(lr)
@@ -0,0 +1,108 @@
+package search
+
+import (
+	"math"
+	"strings"
+)
+
+const (
+	bm25K1 = 1.2
+	bm25B  = 0.75
+)
+
+// Field boosts for the BM25F-style term frequency: a title hit counts as much
+// as three hits in the company name or description.
+const (
+	bm25TitleBoost       = 3.0
+	bm25CompanyBoost     = 1.0
+	bm25DescriptionBoost = 1.0
+)
+
+// bm25Corpus holds term statistics for a set of jobs. Document frequencies
+// come from the jobs being ranked, so a term every result shares counts for
+// little and a rarer one separates them.
+type bm25Corpus struct {
+	docs   int
+	df     map[string]int
+	avgLen float64
+}
+
+type bm25Doc struct {
+	tf     map[string]float64
+	length float64
+}
+
+func newBM25Corpus(jobs []Job) *bm25Corpus {
+	c := &bm25Corpus{docs: len(jobs), df: map[string]int{}}
+	total := 0.0
+	for _, j := range jobs {
+		d := newBM25Doc(j)
+		total += d.length
+		for t := range d.tf {
+			c.df[t]++
+		}
+	}
+	if len(jobs) > 0 {
+		c.avgLen = total / float64(len(jobs))
+	}
+	return c
+}
+
+func newBM25Doc(job Job) bm25Doc {
+	d := bm25Doc{tf: map[string]float64{}}
+	add := func(text string, boost float64) {
+		for _, t := range strings.Fields(NormalizeQuery(text)) {
+			d.tf[t] += boost
+			d.length += boost
+		}
+	}
+	add(job.Title, bm25TitleBoost)
+	add(job.CompanyName, bm25CompanyBoost)
+	add(job.Description, bm25DescriptionBoost)
+	return d
+}
+
+// score returns the job's BM25 score as a share of the best score the terms
+// could reach, scaled to 0..10.
+func (c *bm25Corpus) score(job Job, terms []string) float64 {
+	if len(terms) == 0 || c.docs == 0 {
+		return 0
+	}
+	d := newBM25Doc(job)
+	norm := 1.0
+	if c.avgLen > 0 {
+		norm = 1 - bm25B + bm25B*d.length/c.avgLen
+	}
+
+	score, ideal := 0.0, 0.0
+	for _, t := range terms {
+		n := float64(c.df[t])
+		idf := math.Log(1 + (float64(c.docs)-n+0.5)/(n+0.5))
+		ideal += idf * (bm25K1 + 1)
+		tf := d.tf[t]
+		if tf == 0 {
+			continue
+		}
+		score += idf * tf * (bm25K1 + 1) / (tf + bm25K1*norm)
+	}
+	if ideal == 0 {
+		return 0
+	}
+	return math.Min(10, 10*score/ideal)
+}
+
+// queryTerms splits the query variants into distinct words.
+func queryTerms(variants []string) []string {
+	seen := map[string]struct{}{}
+	out := make([]string, 0, len(variants))
+	for _, v := range variants {
+		for _, t := range strings.Fields(NormalizeQuery(v)) {
+			if _, ok := seen[t]; ok {
+				continue
+			}
+			seen[t] = struct{}{}
+			out = append(out, t)
+		}
+	}
+	return out
+}
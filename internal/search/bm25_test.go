@@ -0,0 +1,48 @@
+package search
+
+import "testing"
+
+func TestBM25PrefersTitleMatches(t *testing.T) {
+	jobs := []Job{
+		{Title: "Golang Engineer", Description: "Build services for our platform."},
+		{Title: "Backend Engineer", Description: "Build services in Golang for our platform."},
+		{Title: "Data Analyst", Description: "Reporting and dashboards."},
+	}
+	c := newBM25Corpus(jobs)
+	terms := queryTerms([]string{"golang"})
+
+	title, desc, none := c.score(jobs[0], terms), c.score(jobs[1], terms), c.score(jobs[2], terms)
+	if !(title > desc && desc > none) {
+		t.Fatalf("expected title > description > no match, got %v %v %v", title, desc, none)
+	}
+	if none != 0 || title > 10 {
+		t.Fatalf("expected scores within 0..10, got %v %v", none, title)
+	}
+}
+
+func TestBM25RareTermsCountMore(t *testing.T) {
+	jobs := []Job{
+		{Title: "Engineer Kotlin"},
+		{Title: "Engineer Java"},
+		{Title: "Engineer Java"},
+	}
+	c := newBM25Corpus(jobs)
+	terms := queryTerms([]string{"engineer kotlin", "java"})
+	if c.score(jobs[0], terms) <= c.score(jobs[1], terms) {
+		t.Fatalf("expected the rarer term to weigh more")
+	}
+}
+
+func TestRankJobsUsesSourceWeight(t *testing.T) {
+	jobs := []Job{
+		{Title: "Backend Engineer", Source: "Dev.to Jobs"},
+		{Title: "Backend Engineer", Source: "Company Careers", SourceWeight: 4},
+	}
+	ranked, scores := RankJobs(jobs, []string{"backend engineer"})
+	if ranked[0].Source != "Company Careers" || scores[0].SourceQuality != 4 {
+		t.Fatalf("expected the weighted source first, got %+v", scores)
+	}
+	if scores[1].SourceQuality != DefaultSourceWeight {
+		t.Fatalf("expected default source weight, got %v", scores[1].SourceQuality)
+	}
+}
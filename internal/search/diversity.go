@@ -0,0 +1,195 @@
+package search
+
+import (
+	"regexp"
+	"strings"
+
+	"github.com/google/uuid"
+)
+
+const (
+	RerankPromoted      = "promoted:diversity"
+	RerankSameCompany   = "demoted:same_company"
+	RerankSimilarTitle  = "demoted:similar_title"
+	RerankSameLocation  = "demoted:same_location"
+	RerankCompanyCapped = "demoted:company_cap"
+	RerankDisplaced     = "demoted:displaced"
+)
+
+// Similarity weights between two jobs; they sum to 1.
+const (
+	companySimilarity  = 0.5
+	titleSimilarity    = 0.35
+	locationSimilarity = 0.15
+)
+
+type DiversityItem struct {
+	ID          uuid.UUID
+	Score       float64
+	CompanyName string
+	Title       string
+	Location    string
+}
+
+type DiversityResult struct {
+	ID           uuid.UUID
+	Rank         int
+	OriginalRank int
+	Reason       string
+}
+
+var (
+	titleParenRe = regexp.MustCompile(`\([^)]*\)|\[[^\]]*\]`)
+	titleNoiseRe = regexp.MustCompile(`[^a-z0-9+#]+`)
+)
+
+var titleNoiseWords = map[string]struct{}{
+	"intern": {}, "internship": {}, "magang": {}, "junior": {}, "jr": {}, "mid": {}, "middle": {},
+	"senior": {}, "sr": {}, "lead": {}, "principal": {}, "staff": {}, "i": {}, "ii": {}, "iii": {},
+	"iv": {}, "remote": {}, "hybrid": {}, "urgent": {}, "hiring": {}, "dibutuhkan": {}, "segera": {},
+}
+
+// TitleCluster reduces a job title to a key shared by near-identical openings,
+// e.g. "Senior Backend Engineer (Go) - Jakarta" and "Backend Engineer II".
+func TitleCluster(title string) string {
+	t := strings.ToLower(title)
+	for _, sep := range []string{" - ", " | ", " – "} {
+		if i := strings.Index(t, sep); i > 0 {
+			t = t[:i]
+		}
+	}
+	t = titleParenRe.ReplaceAllString(t, " ")
+	t = titleNoiseRe.ReplaceAllString(t, " ")
+
+	parts := make([]string, 0, 4)
+	for _, f := range strings.Fields(t) {
+		if _, ok := titleNoiseWords[f]; ok {
+			continue
+		}
+		parts = append(parts, f)
+	}
+	return strings.Join(parts, " ")
+}
+
+// Diversify re-ranks items that are already sorted by score using maximal
+// marginal relevance: each pick maximises
+//
+//	(1-diversity)*score - diversity*max_similarity_to_picked
+//
+// diversity=0 keeps the input order. maxPerCompany > 0 additionally holds
+// back a company's extra openings until every other candidate is placed.
+func Diversify(items []DiversityItem, diversity float64, maxPerCompany int) []DiversityResult {
+	if diversity < 0 {
+		diversity = 0
+	}
+	if diversity > 1 {
+		diversity = 1
+	}
+
+	out := make([]DiversityResult, 0, len(items))
+	if diversity == 0 && maxPerCompany <= 0 {
+		for i, it := range items {
+			out = append(out, DiversityResult{ID: it.ID, Rank: i + 1, OriginalRank: i + 1})
+		}
+		return out
+	}
+
+	type candidate struct {
+		item     DiversityItem
+		original int
+		company  string
+		title    string
+		location string
+		maxSim   float64
+		reason   string
+	}
+
+	remaining := make([]*candidate, 0, len(items))
+	for i, it := range items {
+		remaining = append(remaining, &candidate{
+			item:     it,
+			original: i + 1,
+			company:  strings.ToLower(strings.TrimSpace(it.CompanyName)),
+			title:    TitleCluster(it.Title),
+			location: strings.ToLower(strings.TrimSpace(it.Location)),
+		})
+	}
+
+	perCompany := map[string]int{}
+	for len(remaining) > 0 {
+		capped := func(c *candidate) bool {
+			return maxPerCompany > 0 && c.company != "" && perCompany[c.company] >= maxPerCompany
+		}
+		allCapped := true
+		for _, c := range remaining {
+			if !capped(c) {
+				allCapped = false
+				break
+			}
+		}
+
+		best := -1
+		bestVal := 0.0
+		for i, c := range remaining {
+			if !allCapped && capped(c) {
+				continue
+			}
+			val := (1-diversity)*c.item.Score/100 - diversity*c.maxSim
+			if best < 0 || val > bestVal {
+				best = i
+				bestVal = val
+			}
+		}
+
+		picked := remaining[best]
+		remaining = append(remaining[:best], remaining[best+1:]...)
+
+		rank := len(out) + 1
+		reason := ""
+		switch {
+		case rank < picked.original:
+			reason = RerankPromoted
+		case rank > picked.original && allCapped && capped(picked):
+			reason = RerankCompanyCapped
+		case rank > picked.original && picked.reason != "":
+			reason = picked.reason
+		case rank > picked.original:
+			reason = RerankDisplaced
+		}
+		out = append(out, DiversityResult{ID: picked.item.ID, Rank: rank, OriginalRank: picked.original, Reason: reason})
+		if picked.company != "" {
+			perCompany[picked.company]++
+		}
+
+		for _, c := range remaining {
+			sim, why := 0.0, ""
+			strongest := 0.0
+			if c.company != "" && c.company == picked.company {
+				sim += companySimilarity
+				strongest, why = companySimilarity, RerankSameCompany
+			}
+			if c.title != "" && c.title == picked.title {
+				sim += titleSimilarity
+				if titleSimilarity > strongest {
+					strongest, why = titleSimilarity, RerankSimilarTitle
+				}
+			}
+			if c.location != "" && c.location == picked.location {
+				sim += locationSimilarity
+				if locationSimilarity > strongest {
+					why = RerankSameLocation
+				}
+			}
+			if sim > c.maxSim {
+				c.maxSim = sim
+				c.reason = why
+			}
+		}
+		for _, c := range remaining {
+			if capped(c) {
+				c.reason = RerankCompanyCapped
+			}
+		}
+	}
+	return out
+}
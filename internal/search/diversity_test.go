@@ -0,0 +1,39 @@
+package search
+
+import (
+	"testing"
+
+	"github.com/google/uuid"
+)
+
+func TestDiversifySpreadsCompanies(t *testing.T) {
+	items := []DiversityItem{
+		{ID: uuid.New(), Score: 90, CompanyName: "Acme", Title: "Senior Backend Engineer (Go)", Location: "Jakarta"},
+		{ID: uuid.New(), Score: 89, CompanyName: "Acme", Title: "Backend Engineer II", Location: "Jakarta"},
+		{ID: uuid.New(), Score: 88, CompanyName: "Acme", Title: "Backend Engineer - Remote", Location: "Jakarta"},
+		{ID: uuid.New(), Score: 80, CompanyName: "Globex", Title: "Data Engineer", Location: "Bandung"},
+	}
+
+	plain := Diversify(items, 0, 0)
+	for i, r := range plain {
+		if r.ID != items[i].ID || r.Reason != "" {
+			t.Fatalf("expected diversity=0 to keep order, got %+v", plain)
+		}
+	}
+
+	out := Diversify(items, 0.5, 0)
+	if out[0].ID != items[0].ID {
+		t.Fatalf("expected top match to stay first, got %+v", out[0])
+	}
+	if out[1].ID != items[3].ID || out[1].Reason != RerankPromoted {
+		t.Fatalf("expected other company promoted to second, got %+v", out[1])
+	}
+	if out[2].Reason != RerankSameCompany {
+		t.Fatalf("expected same company demotion, got %q", out[2].Reason)
+	}
+
+	capped := Diversify(items, 0, 1)
+	if capped[1].ID != items[3].ID || capped[2].Reason != RerankCompanyCapped {
+		t.Fatalf("expected company cap to hold back repeats, got %+v", capped)
+	}
+}
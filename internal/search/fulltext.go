@@ -0,0 +1,43 @@
+package search
+
+import "strings"
+
+// TextQuery builds the input for Postgres websearch_to_tsquery. A query that
+// already uses websearch syntax (quoted phrases, -exclusions) is passed through
+// as typed; otherwise the expanded variants are OR-ed so synonyms still match.
+func TextQuery(original string, variants []string) string {
+	original = strings.TrimSpace(original)
+	if usesWebsearchSyntax(original) {
+		return original
+	}
+
+	parts := make([]string, 0, len(variants))
+	seen := make(map[string]struct{}, len(variants))
+	for _, v := range variants {
+		v = strings.TrimSpace(v)
+		if v == "" {
+			continue
+		}
+		if _, ok := seen[v]; ok {
+			continue
+		}
+		seen[v] = struct{}{}
+		parts = append(parts, v)
+	}
+	if len(parts) == 0 {
+		return NormalizeQuery(original)
+	}
+	return strings.Join(parts, " or ")
+}
+
+func usesWebsearchSyntax(q string) bool {
+	if strings.Contains(q, `"`) {
+		return true
+	}
+	for _, w := range strings.Fields(q) {
+		if len(w) > 1 && w[0] == '-' {
+			return true
+		}
+	}
+	return false
+}
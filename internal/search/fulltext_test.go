@@ -0,0 +1,34 @@
+package search
+
+import "testing"
+
+func TestTextQuery(t *testing.T) {
+	cases := []struct {
+		name     string
+		original string
+		variants []string
+		want     string
+	}{
+		{name: "variants are or-ed", original: "Backend Dev", variants: []string{"backend dev", "backend developer", "backend dev"}, want: "backend dev or backend developer"},
+		{name: "phrase passes through", original: ` "data engineer" jakarta `, variants: []string{"data engineer jakarta"}, want: `"data engineer" jakarta`},
+		{name: "exclusion passes through", original: "engineer -intern", variants: []string{"engineer intern"}, want: "engineer -intern"},
+		{name: "hyphenated word is not an exclusion", original: "front-end", variants: nil, want: "frontend"},
+		{name: "empty", original: "  ", variants: nil, want: ""},
+	}
+	for _, tc := range cases {
+		if got := TextQuery(tc.original, tc.variants); got != tc.want {
+			t.Errorf("%s: TextQuery(%q) = %q, want %q", tc.name, tc.original, got, tc.want)
+		}
+	}
+}
+
+func TestScoreJobBlendsTextRank(t *testing.T) {
+	plain := ScoreJob(Job{Title: "Backend Engineer"}, []string{"golang"})
+	ranked := ScoreJob(Job{Title: "Backend Engineer", TextRank: 0.4}, []string{"golang"})
+	if ranked.TextRank != 4 {
+		t.Fatalf("expected text rank scaled to 4, got %v", ranked.TextRank)
+	}
+	if ranked.FinalScore <= plain.FinalScore {
+		t.Fatalf("expected text rank to raise the score, got %v <= %v", ranked.FinalScore, plain.FinalScore)
+	}
+}
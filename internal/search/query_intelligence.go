@@ -8,7 +8,21 @@ import (
 type QueryContext struct {
 	Original   string
 	Normalized string
-	Variants   []string
+	// Query is Normalized without stop words and the extracted location; it
+	// is what titles are searched for.
+	Query string
+	// Stems holds the Indonesian stem of each word of Query.
+	Stems []string
+	// Translated is Query with Indonesian role terms in English ("staf
+	// gudang" -> "warehouse staff"); equal to Query when nothing maps.
+	Translated string
+	// Location is a place named in the query ("admin jaksel"), for use as the
+	// location filter when none was given.
+	Location string
+	// Language is the detected language of the query, "" when unclear; it
+	// picks the synonym set Variants are expanded with.
+	Language string
+	Variants []string
 }
 
 func NormalizeQuery(input string) string {
@@ -44,7 +58,10 @@ func NormalizeQuery(input string) string {
 	return out
 }
 
-func ExpandQuery(normalized string) []string {
+// ExpandQuery returns the query followed by its synonym variants, taken from
+// the dictionary entries for lang plus those valid for every language. An
+// empty lang uses every entry.
+func ExpandQuery(normalized, lang string) []string {
 	normalized = strings.TrimSpace(normalized)
 	if normalized == "" {
 		return []string{}
@@ -65,9 +82,10 @@ func ExpandQuery(normalized string) []string {
 	}
 
 	add(normalized)
+	dict := CurrentDictionary()
 
 	// 1) Synonyms for exact full query
-	for _, syn := range GetSynonyms(normalized) {
+	for _, syn := range dict.Lookup(normalized, lang) {
 		add(syn)
 	}
 
@@ -76,20 +94,11 @@ func ExpandQuery(normalized string) []string {
 	// 2) If query is a single token, try joining-with-space heuristic based on synonym keys.
 	//    e.g. officeboy -> office boy
 	if len(words) == 1 {
-		single := words[0]
-		for k, syns := range Synonyms {
-			if !strings.Contains(k, " ") {
-				continue
-			}
-			kNoSpace := strings.ReplaceAll(k, " ", "")
-			if kNoSpace != single {
-				continue
-			}
+		if k, ok := dict.SpacedTerm(words[0]); ok {
 			add(k)
-			for _, syn := range syns {
+			for _, syn := range dict.Lookup(k, lang) {
 				add(syn)
 			}
-			break
 		}
 	}
 
@@ -100,7 +109,7 @@ func ExpandQuery(normalized string) []string {
 		if phrase == "" {
 			return
 		}
-		syns := GetSynonyms(phrase)
+		syns := dict.Lookup(phrase, lang)
 		if len(syns) == 0 {
 			return
 		}
@@ -124,16 +133,9 @@ func ExpandQuery(normalized string) []string {
 	// 4) If the first token is a compact form of a spaced synonym key, generate spaced prefix variants.
 	//    e.g. officeboy jakarta -> office boy jakarta -> (office|helper|staff) jakarta
 	if len(words) >= 1 && !strings.Contains(words[0], " ") {
-		first := words[0]
 		rest := words[1:]
-		for k, syns := range Synonyms {
-			if !strings.Contains(k, " ") {
-				continue
-			}
-			kNoSpace := strings.ReplaceAll(k, " ", "")
-			if kNoSpace != first {
-				continue
-			}
+		if k, ok := dict.SpacedTerm(words[0]); ok {
+			syns := dict.Lookup(k, lang)
 			base := k
 			if len(rest) > 0 {
 				base = strings.TrimSpace(base + " " + strings.Join(rest, " "))
@@ -147,7 +149,6 @@ func ExpandQuery(normalized string) []string {
 				}
 				add(strings.TrimSpace(syn + " " + restStr))
 			}
-			break
 		}
 	}
 
@@ -157,20 +158,76 @@ func ExpandQuery(normalized string) []string {
 	return out
 }
 
+// LookupKeys returns the dictionary terms ExpandQuery consults for a
+// normalized query, so results can be tied to the entries that shaped them.
+func LookupKeys(normalized string) []string {
+	words := strings.Fields(normalized)
+	if len(words) == 0 {
+		return []string{}
+	}
+	keys := []string{strings.Join(words, " "), words[0]}
+	if len(words) >= 2 {
+		keys = append(keys, words[0]+" "+words[1])
+	}
+	out := make([]string, 0, len(keys))
+	for _, k := range keys {
+		if !containsString(out, k) {
+			out = append(out, k)
+		}
+	}
+	return out
+}
+
 func ProcessQuery(input string) QueryContext {
-	ctx := QueryContext{Original: input}
+	ctx := QueryContext{Original: input, Stems: []string{}, Variants: []string{}}
 	ctx.Normalized = NormalizeQuery(input)
 	if ctx.Normalized == "" {
-		ctx.Variants = []string{}
 		return ctx
 	}
-	ctx.Variants = ExpandQuery(ctx.Normalized)
+	ctx.Language = DetectLanguage(ctx.Normalized)
+	// Queries written in websearch syntax are taken literally.
+	if usesWebsearchSyntax(strings.TrimSpace(input)) {
+		ctx.Query = ctx.Normalized
+		ctx.Translated = ctx.Normalized
+		ctx.Variants = ExpandQuery(ctx.Normalized, ctx.Language)
+		return ctx
+	}
+
+	u := understandQuery(ctx.Normalized)
+	ctx.Query = u.query
+	ctx.Stems = u.stems
+	ctx.Translated = u.translated
+	ctx.Location = u.location
+	if ctx.Query == "" {
+		return ctx
+	}
+	ctx.Variants = ExpandQuery(ctx.Query, ctx.Language)
+	if ctx.Translated != ctx.Query {
+		// The translation goes right after the query so the cap below never
+		// drops it. It is English, so it takes the English synonyms.
+		out := []string{ctx.Query}
+		for _, v := range append(ExpandQuery(ctx.Translated, LanguageEnglish), ctx.Variants[1:]...) {
+			if !containsString(out, v) {
+				out = append(out, v)
+			}
+		}
+		ctx.Variants = out
+	}
 	if len(ctx.Variants) > 10 {
 		ctx.Variants = ctx.Variants[:10]
 	}
 	return ctx
 }
 
+// TextQuery is the full-text input for the understood query; empty when
+// nothing but stop words and a location was typed.
+func (c QueryContext) TextQuery() string {
+	if c.Query == "" {
+		return ""
+	}
+	return TextQuery(c.Original, c.Variants)
+}
+
 func FallbackFirstWord(normalized string) string {
 	normalized = strings.TrimSpace(normalized)
 	if normalized == "" {
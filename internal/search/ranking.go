@@ -3,6 +3,7 @@ package search
 import (
 	"sort"
 	"strings"
+	"sync/atomic"
 	"time"
 
 	"github.com/google/uuid"
@@ -19,59 +20,78 @@ type Job struct {
 	Source        string
 	CreatedAt     time.Time
 	PostedAt      *time.Time
+	// TextRank is the database full-text rank (ts_rank_cd, normalised to 0..1).
+	TextRank float64
+	// SourceWeight is job_sources.ranking_weight for the job's source; zero
+	// means the source has none and DefaultSourceWeight applies.
+	SourceWeight float64
+	// DistanceKm and RadiusKm are set for searches around a location.
+	DistanceKm *float64
+	RadiusKm   float64
 }
 
 type JobScore struct {
 	JobID         uuid.UUID
 	Relevance     float64
+	TextRank      float64
 	Freshness     float64
 	SourceQuality float64
 	DataQuality   float64
-	FinalScore    float64
+	// Match is the user's skill match (0..100) scaled to 0..10; only set for
+	// personalised results.
+	Match float64
+	// Proximity is 10 at the search centre, falling to 0 at the radius.
+	Proximity  float64
+	FinalScore float64
 }
 
-var SourceWeights = map[string]float64{
-	"linkedin":     3,
-	"indeed":       3,
-	"glassdoor":    3,
-	"google":       2,
-	"company_site": 4,
-	"unknown":      1,
+// Weights sets how much each component contributes to JobScore.FinalScore.
+type Weights struct {
+	Relevance float64
+	TextRank  float64
+	Freshness float64
+	Source    float64
+	Quality   float64
+	Match     float64
+	Proximity float64
 }
 
-func ComputeRelevance(job Job, queryVariants []string) float64 {
-	if len(queryVariants) == 0 {
-		return 0
-	}
+var DefaultWeights = Weights{Relevance: 2.0, TextRank: 1.5, Freshness: 1.5, Source: 1.0, Quality: 0.5, Match: 2.0, Proximity: 1.5}
 
-	title := strings.ToLower(job.Title)
-	desc := strings.ToLower(job.Description)
-	company := strings.ToLower(job.CompanyName)
+const DefaultSourceWeight = 1.0
 
-	score := 0.0
-	for _, v := range queryVariants {
-		v = strings.TrimSpace(v)
-		if v == "" {
-			continue
-		}
-		v = strings.ToLower(v)
-		if title != "" && strings.Contains(title, v) {
-			score += 3
-		}
-		if desc != "" && strings.Contains(desc, v) {
-			score += 1
-		}
-		if company != "" && strings.Contains(company, v) {
-			score += 1
-		}
-		if score >= 10 {
-			return 10
-		}
+var weights atomic.Pointer[Weights]
+
+func init() {
+	w := DefaultWeights
+	weights.Store(&w)
+}
+
+// SetWeights swaps the weights used by ScoreJob and RankJobs.
+func SetWeights(w Weights) {
+	weights.Store(&w)
+}
+
+func CurrentWeights() Weights {
+	return *weights.Load()
+}
+
+// ComputeRelevance scores a single job against the query on the 0..10 scale.
+// RankJobs scores a result page together so term rarity across the page counts.
+func ComputeRelevance(job Job, queryVariants []string) float64 {
+	return newBM25Corpus([]Job{job}).score(job, queryTerms(queryVariants))
+}
+
+// ComputeTextRank scales the database rank to the 0..10 range of
+// ComputeRelevance.
+func ComputeTextRank(job Job) float64 {
+	if job.TextRank <= 0 {
+		return 0
 	}
-	if score > 10 {
-		score = 10
+	if job.TextRank >= 1 {
+		return 10
 	}
-	return score
+	return job.TextRank * 10
 }
 
 func ComputeFreshness(job Job) float64 {
@@ -108,15 +128,25 @@ func ComputeFreshness(job Job) float64 {
 	return 0
 }
 
-func ComputeSourceQuality(source string) float64 {
-	source = strings.TrimSpace(strings.ToLower(source))
-	if source == "" {
-		source = "unknown"
+func ComputeSourceQuality(job Job) float64 {
+	if job.SourceWeight > 0 {
+		return job.SourceWeight
 	}
-	if w, ok := SourceWeights[source]; ok {
-		return w
+	return DefaultSourceWeight
+}
+
+func ComputeProximity(job Job) float64 {
+	if job.DistanceKm == nil || job.RadiusKm <= 0 {
+		return 0
 	}
-	return 1
+	p := 10 * (1 - *job.DistanceKm/job.RadiusKm)
+	if p < 0 {
+		return 0
+	}
+	if p > 10 {
+		return 10
+	}
+	return p
 }
 
 func ComputeDataQuality(job Job) float64 {
@@ -143,56 +173,77 @@ func ComputeDataQuality(job Job) float64 {
 }
 
 func ScoreJob(job Job, queryVariants []string) JobScore {
-	rel := ComputeRelevance(job, queryVariants)
+	return scoreJob(job, ComputeRelevance(job, queryVariants), CurrentWeights())
+}
+
+func scoreJob(job Job, rel float64, w Weights) JobScore {
+	text := ComputeTextRank(job)
 	fresh := ComputeFreshness(job)
-	src := ComputeSourceQuality(job.Source)
+	src := ComputeSourceQuality(job)
 	qual := ComputeDataQuality(job)
+	prox := ComputeProximity(job)
 
-	final := (rel * 2.0) + (fresh * 1.5) + (src * 1.0) + (qual * 0.5)
+	final := (rel * w.Relevance) + (text * w.TextRank) + (fresh * w.Freshness) + (src * w.Source) + (qual * w.Quality) + (prox * w.Proximity)
 
 	return JobScore{
 		JobID:         job.ID,
 		Relevance:     rel,
+		TextRank:      text,
 		Freshness:     fresh,
 		SourceQuality: src,
 		DataQuality:   qual,
+		Proximity:     prox,
 		FinalScore:    final,
 	}
 }
 
-func RankJobs(jobs []Job, queryVariants []string) []Job {
+// BlendMatch adds a user's match score (0..100) to a job's score.
+func BlendMatch(score JobScore, matchScore int) JobScore {
+	m := float64(matchScore) / 10
+	if m < 0 {
+		m = 0
+	}
+	if m > 10 {
+		m = 10
+	}
+	score.Match = m
+	score.FinalScore += m * CurrentWeights().Match
+	return score
+}
+
+// RankJobs orders jobs by FinalScore and returns the scores in the same
+// order. Jobs keep their input order when every score is zero.
+func RankJobs(jobs []Job, queryVariants []string) ([]Job, []JobScore) {
 	if len(jobs) == 0 {
-		return jobs
+		return jobs, nil
 	}
 
-	maxScore := 0.0
-	scored := make([]struct {
-		idx   int
-		score float64
-	}, len(jobs))
+	corpus := newBM25Corpus(jobs)
+	terms := queryTerms(queryVariants)
+	w := CurrentWeights()
 
+	maxScore := 0.0
+	scores := make([]JobScore, len(jobs))
+	order := make([]int, len(jobs))
 	for i := range jobs {
-		s := ScoreJob(jobs[i], queryVariants).FinalScore
-		scored[i] = struct {
-			idx   int
-			score float64
-		}{idx: i, score: s}
-		if s > maxScore {
-			maxScore = s
+		scores[i] = scoreJob(jobs[i], corpus.score(jobs[i], terms), w)
+		order[i] = i
+		if scores[i].FinalScore > maxScore {
+			maxScore = scores[i].FinalScore
 		}
 	}
 
-	if maxScore == 0 {
-		return jobs
+	if maxScore > 0 {
+		sort.SliceStable(order, func(i, j int) bool {
+			return scores[order[i]].FinalScore > scores[order[j]].FinalScore
+		})
 	}
 
-	sort.SliceStable(scored, func(i, j int) bool {
-		return scored[i].score > scored[j].score
-	})
-
-	out := make([]Job, 0, len(jobs))
-	for _, it := range scored {
-		out = append(out, jobs[it.idx])
+	outJobs := make([]Job, 0, len(jobs))
+	outScores := make([]JobScore, 0, len(jobs))
+	for _, idx := range order {
+		outJobs = append(outJobs, jobs[idx])
+		outScores = append(outScores, scores[idx])
 	}
-	return out
+	return outJobs, outScores
 }
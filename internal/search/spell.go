@@ -0,0 +1,157 @@
+package search
+
+import "strings"
+
+// SpellCorrector suggests corrections for query tokens that are not in a
+// vocabulary of known words (job titles, skills, synonyms) weighted by how
+// often each word appears.
+type SpellCorrector struct {
+	freq map[string]int
+	// byLen buckets words by rune count so only plausible lengths are compared.
+	byLen map[int][]string
+}
+
+// minKnownFrequency is how often a word must appear before it is trusted as
+// correctly spelled; rarer words can still be typos in the scraped titles.
+const minKnownFrequency = 2
+
+func NewSpellCorrector(vocab map[string]int) *SpellCorrector {
+	s := &SpellCorrector{freq: make(map[string]int, len(vocab)), byLen: map[int][]string{}}
+	for w, n := range vocab {
+		w = strings.ToLower(strings.TrimSpace(w))
+		if w == "" || n <= 0 {
+			continue
+		}
+		if _, ok := s.freq[w]; !ok {
+			l := len([]rune(w))
+			s.byLen[l] = append(s.byLen[l], w)
+		}
+		s.freq[w] += n
+	}
+	return s
+}
+
+func (s *SpellCorrector) Size() int {
+	if s == nil {
+		return 0
+	}
+	return len(s.freq)
+}
+
+// Correct returns the query with unknown tokens replaced by their closest
+// vocabulary word, and whether anything changed. The input is normalized first.
+func (s *SpellCorrector) Correct(query string) (string, bool) {
+	normalized := NormalizeQuery(query)
+	if s == nil || len(s.freq) == 0 || normalized == "" {
+		return normalized, false
+	}
+
+	words := strings.Fields(normalized)
+	changed := false
+	for i, w := range words {
+		if c, ok := s.correctWord(w); ok {
+			words[i] = c
+			changed = true
+		}
+	}
+	return strings.Join(words, " "), changed
+}
+
+func (s *SpellCorrector) correctWord(w string) (string, bool) {
+	if s.freq[w] >= minKnownFrequency || isNumeric(w) {
+		return "", false
+	}
+	maxDist := maxEditDistance(len([]rune(w)))
+	if maxDist == 0 {
+		return "", false
+	}
+
+	best, bestDist, bestFreq := "", maxDist+1, 0
+	n := len([]rune(w))
+	for l := n - maxDist; l <= n+maxDist; l++ {
+		for _, cand := range s.byLen[l] {
+			freq := s.freq[cand]
+			if freq < minKnownFrequency || cand == w {
+				continue
+			}
+			d := editDistance(w, cand, maxDist)
+			if d > maxDist {
+				continue
+			}
+			if d < bestDist || (d == bestDist && (freq > bestFreq || (freq == bestFreq && cand < best))) {
+				best, bestDist, bestFreq = cand, d, freq
+			}
+		}
+	}
+	if best == "" {
+		return "", false
+	}
+	return best, true
+}
+
+// maxEditDistance keeps short tokens ("qa", "hr", "ob") from being rewritten
+// into unrelated words.
+func maxEditDistance(runes int) int {
+	switch {
+	case runes <= 3:
+		return 0
+	case runes <= 7:
+		return 1
+	default:
+		return 2
+	}
+}
+
+// editDistance is the optimal string alignment distance (Levenshtein plus
+// adjacent transpositions, so "backedn" is one edit from "backend"). It stops
+// early once every path exceeds limit.
+func editDistance(a, b string, limit int) int {
+	ra, rb := []rune(a), []rune(b)
+	if abs(len(ra)-len(rb)) > limit {
+		return limit + 1
+	}
+
+	prev2 := make([]int, len(rb)+1)
+	prev := make([]int, len(rb)+1)
+	cur := make([]int, len(rb)+1)
+	for j := range prev {
+		prev[j] = j
+	}
+	for i := 1; i <= len(ra); i++ {
+		cur[0] = i
+		rowMin := cur[0]
+		for j := 1; j <= len(rb); j++ {
+			cost := 1
+			if ra[i-1] == rb[j-1] {
+				cost = 0
+			}
+			v := min(prev[j]+1, cur[j-1]+1, prev[j-1]+cost)
+			if i > 1 && j > 1 && ra[i-1] == rb[j-2] && ra[i-2] == rb[j-1] {
+				v = min(v, prev2[j-2]+1)
+			}
+			cur[j] = v
+			rowMin = min(rowMin, v)
+		}
+		if rowMin > limit {
+			return limit + 1
+		}
+		prev2, prev, cur = prev, cur, prev2
+	}
+	return prev[len(rb)]
+}
+
+func isNumeric(w string) bool {
+	for _, r := range w {
+		if r < '0' || r > '9' {
+			return false
+		}
+	}
+	return w != ""
+}
+
+func abs(v int) int {
+	if v < 0 {
+		return -v
+	}
+	return v
+}
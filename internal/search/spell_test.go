@@ -0,0 +1,34 @@
+package search
+
+import "testing"
+
+func TestSpellCorrector(t *testing.T) {
+	s := NewSpellCorrector(map[string]int{
+		"programmer": 40,
+		"backend":    25,
+		"developer":  30,
+		"jakarta":    50,
+		"qa":         10,
+		"engineer":   60,
+		"engineers":  3,
+	})
+
+	cases := []struct {
+		in      string
+		want    string
+		changed bool
+	}{
+		{in: "progammer", want: "programmer", changed: true},
+		{in: "Backedn Developer", want: "backend developer", changed: true},
+		{in: "enginer jakarta", want: "engineer jakarta", changed: true},
+		{in: "backend developer", want: "backend developer", changed: false},
+		{in: "qz", want: "qz", changed: false},
+		{in: "xyzzyplugh", want: "xyzzyplugh", changed: false},
+	}
+	for _, tc := range cases {
+		got, changed := s.Correct(tc.in)
+		if got != tc.want || changed != tc.changed {
+			t.Errorf("Correct(%q) = %q, %v; want %q, %v", tc.in, got, changed, tc.want, tc.changed)
+		}
+	}
+}
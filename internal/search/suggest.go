@@ -0,0 +1,133 @@
+package search
+
+import (
+	"sort"
+	"strings"
+)
+
+const (
+	SuggestTitle    = "title"
+	SuggestSkill    = "skill"
+	SuggestCompany  = "company"
+	SuggestLocation = "location"
+)
+
+var SuggestTypes = []string{SuggestTitle, SuggestSkill, SuggestCompany, SuggestLocation}
+
+// popularityWeight is how many job postings one recorded search is worth.
+const popularityWeight = 3
+
+type SuggestTerm struct {
+	Type string
+	Text string
+	// Count is the number of active jobs carrying the term.
+	Count int
+	// Popularity is how often the term was searched.
+	Popularity int
+}
+
+type Suggestion struct {
+	Text  string
+	Count int
+	Score float64
+}
+
+// SuggestIndex answers prefix lookups from memory. Each term is indexed under
+// every word it contains, so "eng" completes "Backend Engineer" as well as
+// "Engineering Manager"; matches on the first word rank higher.
+type SuggestIndex struct {
+	byType map[string]*prefixList
+}
+
+type prefixList struct {
+	terms []SuggestTerm
+	keys  []prefixKey
+}
+
+type prefixKey struct {
+	key     string
+	term    int
+	leading bool
+}
+
+func NewSuggestIndex(terms []SuggestTerm) *SuggestIndex {
+	x := &SuggestIndex{byType: map[string]*prefixList{}}
+	for _, t := range terms {
+		norm := NormalizeQuery(t.Text)
+		if norm == "" || t.Count <= 0 {
+			continue
+		}
+		l := x.byType[t.Type]
+		if l == nil {
+			l = &prefixList{}
+			x.byType[t.Type] = l
+		}
+		id := len(l.terms)
+		l.terms = append(l.terms, t)
+
+		words := strings.Fields(norm)
+		for i := range words {
+			l.keys = append(l.keys, prefixKey{key: strings.Join(words[i:], " "), term: id, leading: i == 0})
+		}
+	}
+	for _, l := range x.byType {
+		sort.Slice(l.keys, func(i, j int) bool { return l.keys[i].key < l.keys[j].key })
+	}
+	return x
+}
+
+func (x *SuggestIndex) Size() int {
+	if x == nil {
+		return 0
+	}
+	n := 0
+	for _, l := range x.byType {
+		n += len(l.terms)
+	}
+	return n
+}
+
+// Suggest returns up to limit completions per type for the given prefix.
+func (x *SuggestIndex) Suggest(prefix string, limit int) map[string][]Suggestion {
+	out := make(map[string][]Suggestion, len(SuggestTypes))
+	for _, typ := range SuggestTypes {
+		out[typ] = []Suggestion{}
+	}
+	p := NormalizeQuery(prefix)
+	if x == nil || p == "" || limit <= 0 {
+		return out
+	}
+
+	for typ, l := range x.byType {
+		start := sort.Search(len(l.keys), func(i int) bool { return l.keys[i].key >= p })
+		best := map[int]float64{}
+		for i := start; i < len(l.keys) && strings.HasPrefix(l.keys[i].key, p); i++ {
+			k := l.keys[i]
+			t := l.terms[k.term]
+			score := float64(t.Count + popularityWeight*t.Popularity)
+			if k.leading {
+				score *= 1.5
+			}
+			if score > best[k.term] {
+				best[k.term] = score
+			}
+		}
+
+		items := make([]Suggestion, 0, len(best))
+		for id, score := range best {
+			t := l.terms[id]
+			items = append(items, Suggestion{Text: t.Text, Count: t.Count, Score: score})
+		}
+		sort.Slice(items, func(i, j int) bool {
+			if items[i].Score != items[j].Score {
+				return items[i].Score > items[j].Score
+			}
+			return items[i].Text < items[j].Text
+		})
+		if len(items) > limit {
+			items = items[:limit]
+		}
+		out[typ] = items
+	}
+	return out
+}
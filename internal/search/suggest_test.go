@@ -0,0 +1,32 @@
+package search
+
+import "testing"
+
+func TestSuggestIndex(t *testing.T) {
+	x := NewSuggestIndex([]SuggestTerm{
+		{Type: SuggestTitle, Text: "Backend Engineer", Count: 40},
+		{Type: SuggestTitle, Text: "Backend Developer", Count: 10, Popularity: 20},
+		{Type: SuggestTitle, Text: "Data Engineer", Count: 30},
+		{Type: SuggestSkill, Text: "Go", Count: 25},
+		{Type: SuggestSkill, Text: "Golang", Count: 5},
+		{Type: SuggestCompany, Text: "Gojek", Count: 7},
+	})
+
+	got := x.Suggest("back", 5)[SuggestTitle]
+	if len(got) != 2 || got[0].Text != "Backend Developer" || got[1].Text != "Backend Engineer" {
+		t.Fatalf("expected popularity to lift Backend Developer, got %+v", got)
+	}
+
+	got = x.Suggest("eng", 5)[SuggestTitle]
+	if len(got) != 2 || got[0].Text != "Backend Engineer" {
+		t.Fatalf("expected mid-title matches ranked by count, got %+v", got)
+	}
+
+	all := x.Suggest("go", 1)
+	if len(all[SuggestSkill]) != 1 || all[SuggestSkill][0].Text != "Go" {
+		t.Fatalf("expected limit per type, got %+v", all[SuggestSkill])
+	}
+	if len(all[SuggestCompany]) != 1 || len(all[SuggestLocation]) != 0 {
+		t.Fatalf("expected every type present, got %+v", all)
+	}
+}
@@ -1,5 +1,17 @@
 package search
 
+import (
+	"sort"
+	"strings"
+	"sync/atomic"
+)
+
+const (
+	LanguageIndonesian = "id"
+	LanguageEnglish    = "en"
+)
+
+// Synonyms is the built-in dictionary, used until the database one is loaded.
 var Synonyms = map[string][]string{
 	"office boy": {"office", "helper", "staff"},
 	"frontend":   {"front end", "frontend developer", "ui developer"},
@@ -8,14 +20,161 @@ var Synonyms = map[string][]string{
 	"admin":      {"administration", "staff admin"},
 }
 
-func GetSynonyms(query string) []string {
-	if query == "" {
+func IsValidLanguage(lang string) bool {
+	return lang == LanguageIndonesian || lang == LanguageEnglish
+}
+
+// SynonymEntry is one dictionary row. A one-way entry expands Term into
+// Synonyms only; a two-way entry makes every member expand to all the others.
+type SynonymEntry struct {
+	Language string
+	Term     string
+	Synonyms []string
+	TwoWay   bool
+}
+
+// Dictionary is an immutable synonym lookup, keyed by normalized term per
+// language.
+type Dictionary struct {
+	byLang map[string]map[string][]string
+	// compact maps a spaced term with the spaces removed back to the term,
+	// so "officeboy" can be recognised as "office boy".
+	compact map[string]string
+}
+
+func NewDictionary(entries []SynonymEntry) *Dictionary {
+	d := &Dictionary{byLang: map[string]map[string][]string{}, compact: map[string]string{}}
+	for _, e := range entries {
+		term := NormalizeQuery(e.Term)
+		if term == "" {
+			continue
+		}
+		syns := make([]string, 0, len(e.Synonyms))
+		for _, s := range e.Synonyms {
+			if s = NormalizeQuery(s); s != "" && s != term {
+				syns = append(syns, s)
+			}
+		}
+		if len(syns) == 0 {
+			continue
+		}
+		d.add(e.Language, term, syns)
+		if !e.TwoWay {
+			continue
+		}
+		members := append([]string{term}, syns...)
+		for _, m := range syns {
+			others := make([]string, 0, len(members)-1)
+			for _, o := range members {
+				if o != m {
+					others = append(others, o)
+				}
+			}
+			d.add(e.Language, m, others)
+		}
+	}
+	return d
+}
+
+// DefaultDictionary wraps the built-in Synonyms as one-way entries valid for
+// every language.
+func DefaultDictionary() *Dictionary {
+	entries := make([]SynonymEntry, 0, len(Synonyms))
+	for term, syns := range Synonyms {
+		entries = append(entries, SynonymEntry{Term: term, Synonyms: syns})
+	}
+	return NewDictionary(entries)
+}
+
+func (d *Dictionary) add(lang, term string, syns []string) {
+	set := d.byLang[lang]
+	if set == nil {
+		set = map[string][]string{}
+		d.byLang[lang] = set
+	}
+	for _, s := range syns {
+		if !containsString(set[term], s) {
+			set[term] = append(set[term], s)
+		}
+	}
+	if strings.Contains(term, " ") {
+		d.compact[strings.ReplaceAll(term, " ", "")] = term
+	}
+}
+
+// Lookup returns the synonyms of a normalized term. An empty language merges
+// every set, since most queries do not say which language they are in.
+func (d *Dictionary) Lookup(term, lang string) []string {
+	if d == nil || term == "" {
 		return []string{}
 	}
-	if v, ok := Synonyms[query]; ok {
-		out := make([]string, 0, len(v))
-		out = append(out, v...)
-		return out
+	langs := make([]string, 0, len(d.byLang))
+	for l := range d.byLang {
+		if lang == "" || l == lang || l == "" {
+			langs = append(langs, l)
+		}
+	}
+	sort.Strings(langs)
+
+	out := make([]string, 0)
+	for _, l := range langs {
+		for _, s := range d.byLang[l][term] {
+			if !containsString(out, s) {
+				out = append(out, s)
+			}
+		}
+	}
+	return out
+}
+
+// SpacedTerm returns the dictionary term that a compact token such as
+// "officeboy" stands for.
+func (d *Dictionary) SpacedTerm(token string) (string, bool) {
+	if d == nil {
+		return "", false
+	}
+	t, ok := d.compact[token]
+	return t, ok
+}
+
+func (d *Dictionary) Size() int {
+	if d == nil {
+		return 0
+	}
+	n := 0
+	for _, set := range d.byLang {
+		n += len(set)
+	}
+	return n
+}
+
+var dictionary atomic.Pointer[Dictionary]
+
+func init() {
+	dictionary.Store(DefaultDictionary())
+}
+
+// SetDictionary swaps the dictionary used by ExpandQuery.
+func SetDictionary(d *Dictionary) {
+	if d == nil {
+		d = DefaultDictionary()
+	}
+	dictionary.Store(d)
+}
+
+func CurrentDictionary() *Dictionary {
+	return dictionary.Load()
+}
+
+func GetSynonyms(query string) []string {
+	return CurrentDictionary().Lookup(query, "")
+}
+
+func containsString(list []string, s string) bool {
+	for _, v := range list {
+		if v == s {
+			return true
+		}
 	}
-	return []string{}
+	return false
 }
@@ -0,0 +1,66 @@
+package search
+
+import (
+	"reflect"
+	"testing"
+)
+
+func TestDictionaryDirections(t *testing.T) {
+	d := NewDictionary([]SynonymEntry{
+		{Language: LanguageIndonesian, Term: "Office Boy", Synonyms: []string{"OB", "pramubakti"}},
+		{Language: LanguageEnglish, Term: "programmer", Synonyms: []string{"developer", "software engineer"}, TwoWay: true},
+	})
+
+	if got := d.Lookup("office boy", ""); !reflect.DeepEqual(got, []string{"ob", "pramubakti"}) {
+		t.Fatalf("one-way term: got %v", got)
+	}
+	if got := d.Lookup("pramubakti", ""); len(got) != 0 {
+		t.Fatalf("one-way synonym must not expand back, got %v", got)
+	}
+	if got := d.Lookup("developer", ""); !reflect.DeepEqual(got, []string{"programmer", "software engineer"}) {
+		t.Fatalf("two-way synonym: got %v", got)
+	}
+	if got := d.Lookup("developer", LanguageIndonesian); len(got) != 0 {
+		t.Fatalf("language filter: got %v", got)
+	}
+	if term, ok := d.SpacedTerm("officeboy"); !ok || term != "office boy" {
+		t.Fatalf("compact form: got %q %v", term, ok)
+	}
+}
+
+func TestExpandQueryUsesLiveDictionary(t *testing.T) {
+	prev := CurrentDictionary()
+	defer SetDictionary(prev)
+
+	SetDictionary(NewDictionary([]SynonymEntry{{Language: LanguageIndonesian, Term: "kurir", Synonyms: []string{"driver"}}}))
+	got := ExpandQuery("kurir jakarta", "")
+	if !reflect.DeepEqual(got, []string{"kurir jakarta", "driver jakarta"}) {
+		t.Fatalf("got %v", got)
+	}
+}
+
+func TestProcessQueryUsesSynonymsOfQueryLanguage(t *testing.T) {
+	prev := CurrentDictionary()
+	defer SetDictionary(prev)
+
+	SetDictionary(NewDictionary([]SynonymEntry{
+		{Language: LanguageIndonesian, Term: "admin", Synonyms: []string{"staf administrasi"}},
+		{Language: LanguageEnglish, Term: "admin", Synonyms: []string{"administrator"}},
+		{Term: "admin", Synonyms: []string{"admin staff"}},
+	}))
+	cases := []struct {
+		query    string
+		language string
+		variants []string
+	}{
+		{"lowongan admin di jakarta", LanguageIndonesian, []string{"admin", "admin staff", "staf administrasi"}},
+		{"admin jobs in jakarta", LanguageEnglish, []string{"admin", "admin staff", "administrator"}},
+		{"admin", "", []string{"admin", "admin staff", "administrator", "staf administrasi"}},
+	}
+	for _, tc := range cases {
+		got := ProcessQuery(tc.query)
+		if got.Language != tc.language || !reflect.DeepEqual(got.Variants, tc.variants) {
+			t.Fatalf("%q: got %q %v, want %q %v", tc.query, got.Language, got.Variants, tc.language, tc.variants)
+		}
+	}
+}
@@ -0,0 +1,344 @@
+package search
+
+import (
+	"strings"
+	"unicode"
+
+	"skill-sync/internal/domain/job"
+)
+
+// stopWords are dropped from free-text queries. They cover the filler
+// Indonesian and English job searches carry ("lowongan kerja admin di
+// jakarta", "jobs in bandung"); "it" and "or" are deliberately absent. They
+// are kept per language because they are also the main language cue.
+var stopWords = map[string]map[string]struct{}{
+	LanguageIndonesian: {
+		"lowongan": {}, "loker": {}, "kerja": {}, "pekerjaan": {}, "kerjaan": {},
+		"di": {}, "ke": {}, "dari": {}, "yang": {}, "dan": {}, "untuk": {}, "dengan": {},
+		"sebagai": {}, "posisi": {}, "dicari": {}, "cari": {}, "butuh": {}, "dibutuhkan": {},
+		"info": {}, "terbaru": {}, "hari": {}, "ini": {}, "daerah": {}, "wilayah": {},
+		"kota": {}, "kabupaten": {}, "area": {}, "bagian": {}, "segera": {},
+	},
+	LanguageEnglish: {
+		"job": {}, "jobs": {}, "vacancy": {}, "vacancies": {}, "hiring": {}, "career": {},
+		"careers": {}, "opening": {}, "openings": {}, "position": {}, "in": {}, "at": {},
+		"near": {}, "the": {}, "a": {}, "an": {}, "for": {}, "of": {}, "and": {}, "to": {},
+		"with": {},
+	},
+}
+
+func IsStopWord(w string) bool {
+	for _, set := range stopWords {
+		if _, ok := set[w]; ok {
+			return true
+		}
+	}
+	return false
+}
+
+// DetectLanguage guesses whether a normalized query is Indonesian or English
+// from its stop words and Indonesian role terms. It returns "" when the
+// query carries no cue either way or as many of each, as "backend developer"
+// does.
+func DetectLanguage(normalized string) string {
+	words := strings.Fields(normalized)
+	score := map[string]int{}
+	for i := 0; i < len(words); i++ {
+		if _, n := matchPhrase(words[i:], roleTerms, maxRolePhraseWords); n > 0 {
+			score[LanguageIndonesian]++
+			i += n - 1
+			continue
+		}
+		if _, ok := roleStems[StemIndonesian(words[i])]; ok {
+			score[LanguageIndonesian]++
+			continue
+		}
+		for lang, set := range stopWords {
+			if _, ok := set[words[i]]; ok {
+				score[lang]++
+			}
+		}
+	}
+	switch id, en := score[LanguageIndonesian], score[LanguageEnglish]; {
+	case id > en:
+		return LanguageIndonesian
+	case en > id:
+		return LanguageEnglish
+	}
+	return ""
+}
+
+// roleTerms maps Indonesian role phrases to their English equivalents. Keys
+// are matched against query words and, for single words, their stems.
+var roleTerms = map[string]string{
+	"pengembang web":             "web developer",
+	"pengembang perangkat lunak": "software developer",
+	"pengembang aplikasi":        "application developer",
+	"pengembang":                 "developer",
+	"pemrogram":                  "programmer",
+	"programer":                  "programmer",
+	"analis data":                "data analyst",
+	"analis":                     "analyst",
+	"insinyur":                   "engineer",
+	"teknisi":                    "technician",
+	"perancang":                  "designer",
+	"desainer":                   "designer",
+	"penulis":                    "writer",
+	"penerjemah":                 "translator",
+	"peneliti":                   "researcher",
+	"konsultan":                  "consultant",
+	"arsitek":                    "architect",
+	"manajer":                    "manager",
+	"pengawas":                   "supervisor",
+	"sekretaris":                 "secretary",
+	"resepsionis":                "receptionist",
+	"akuntan":                    "accountant",
+	"akuntansi":                  "accounting",
+	"pembukuan":                  "bookkeeping",
+	"keuangan":                   "finance",
+	"pemasaran":                  "marketing",
+	"penjualan":                  "sales",
+	"tenaga penjual":             "sales",
+	"kasir":                      "cashier",
+	"staf gudang":                "warehouse staff",
+	"admin gudang":               "warehouse admin",
+	"gudang":                     "warehouse",
+	"staf":                       "staff",
+	"sopir":                      "driver",
+	"supir":                      "driver",
+	"pengemudi":                  "driver",
+	"kurir":                      "courier",
+	"satpam":                     "security",
+	"pelayan":                    "waiter",
+	"juru masak":                 "cook",
+	"koki":                       "chef",
+	"guru":                       "teacher",
+	"pengajar":                   "teacher",
+	"dosen":                      "lecturer",
+	"perawat":                    "nurse",
+	"bidan":                      "midwife",
+	"dokter":                     "doctor",
+	"apoteker":                   "pharmacist",
+	"montir":                     "mechanic",
+	"mekanik":                    "mechanic",
+	"tukang las":                 "welder",
+	"magang":                     "intern",
+}
+
+// roleStems maps stems of derived Indonesian role words ("pengajaran",
+// "penjual") to English. Keys are what StemIndonesian produces, which is not
+// always the dictionary root ("pengemudi" gives "emudi"). Surface forms in
+// roleTerms win.
+var roleStems = map[string]string{
+	"ajar":     "teacher",
+	"embang":   "developer",
+	"tulis":    "writer",
+	"terjemah": "translator",
+	"jual":     "sales",
+	"pasar":    "marketing",
+	"uang":     "finance",
+	"emudi":    "driver",
+	"awas":     "supervisor",
+	"rancang":  "designer",
+	"program":  "programmer",
+	"teliti":   "researcher",
+}
+
+const maxRolePhraseWords = 3
+
+// maxLocationWords bounds the place names looked up in a query, enough for
+// "daerah istimewa yogyakarta".
+const maxLocationWords = 3
+
+// understood is the result of reading a normalized query.
+type understood struct {
+	query      string
+	stems      []string
+	translated string
+	location   string
+}
+
+// understandQuery removes stop words, pulls out the first gazetteer location
+// and maps Indonesian role terms to English.
+func understandQuery(normalized string) understood {
+	words := strings.Fields(normalized)
+	var u understood
+
+	kept := make([]string, 0, len(words))
+	for i := 0; i < len(words); i++ {
+		if u.location == "" {
+			if loc, n := matchLocation(words[i:]); n > 0 {
+				u.location = loc
+				i += n - 1
+				continue
+			}
+		}
+		if IsStopWord(words[i]) {
+			continue
+		}
+		kept = append(kept, words[i])
+	}
+
+	u.query = strings.Join(kept, " ")
+	u.stems = make([]string, 0, len(kept))
+	for _, w := range kept {
+		u.stems = append(u.stems, StemIndonesian(w))
+	}
+
+	translated := make([]string, 0, len(kept))
+	for i := 0; i < len(kept); i++ {
+		if en, n := matchPhrase(kept[i:], roleTerms, maxRolePhraseWords); n > 0 {
+			translated = append(translated, en)
+			i += n - 1
+			continue
+		}
+		if en, ok := roleStems[u.stems[i]]; ok {
+			translated = append(translated, en)
+			continue
+		}
+		translated = append(translated, kept[i])
+	}
+	u.translated = strings.Join(translated, " ")
+	return u
+}
+
+// matchPhrase finds the longest phrase of up to max words at the start of
+// words that is a key of table, returning its value and length in words.
+func matchPhrase(words []string, table map[string]string, max int) (string, int) {
+	for n := min(max, len(words)); n > 0; n-- {
+		if v, ok := table[strings.Join(words[:n], " ")]; ok {
+			return v, n
+		}
+	}
+	return "", 0
+}
+
+// matchLocation finds the longest province or city name at the start of
+// words. Districts are left alone: too many of them ("koja", "senen") are
+// also ordinary words.
+func matchLocation(words []string) (string, int) {
+	for n := min(maxLocationWords, len(words)); n > 0; n-- {
+		loc, ok := job.LookupLocation(strings.Join(words[:n], " "))
+		if ok && (loc.Level == job.LocationProvince || loc.Level == job.LocationCity) {
+			return loc.Name, n
+		}
+	}
+	return "", 0
+}
+
+// minStemRunes keeps affix stripping from cutting words down to fragments.
+const minStemRunes = 4
+
+// StemIndonesian strips common Indonesian inflectional and derivational
+// affixes ("penjualan" -> "jual", "mengajar" -> "ajar"). It works without a
+// root dictionary, so it only accepts cuts that leave a plausible root and
+// leaves short, non-alphabetic and most loan words alone.
+func StemIndonesian(w string) string {
+	if len([]rune(w)) <= minStemRunes || !isLetters(w) {
+		return w
+	}
+	s := w
+	for _, suf := range []string{"lah", "kah", "tah", "pun"} {
+		if cut, ok := trimSuffix(s, suf); ok {
+			s = cut
+			break
+		}
+	}
+	for _, suf := range []string{"nya", "ku", "mu"} {
+		if cut, ok := trimSuffix(s, suf); ok {
+			s = cut
+			break
+		}
+	}
+
+	// Confixes such as pe-an and ke-an come off together: "pemasaran" is
+	// pasar, not pemasar.
+	if base, ok := trimSuffix(s, "an"); ok {
+		for _, pre := range []string{"per", "pe", "ke"} {
+			if strings.HasPrefix(base, pre) {
+				if root, ok := stripPrefix(base); ok {
+					return root
+				}
+			}
+		}
+	}
+	if cut, ok := trimSuffix(s, "kan"); ok {
+		s = cut
+	}
+	if root, ok := stripPrefix(s); ok {
+		return root
+	}
+	return s
+}
+
+// stripPrefix removes one meN-, peN-, ber-/per- or ke- prefix, restoring the
+// root's first letter where the nasal replaced it (menulis -> tulis).
+func stripPrefix(s string) (string, bool) {
+	type rule struct {
+		prefix  string
+		next    string // letters allowed after the prefix; "" means vowels
+		restore string
+	}
+	rules := []rule{
+		{"meng", "", ""}, {"meng", "gh", ""},
+		{"meny", "", "s"},
+		{"mem", "bfpv", ""}, {"mem", "", "p"},
+		{"men", "cdjz", ""}, {"men", "", "t"},
+		{"me", "lmrwy", ""},
+		{"peng", "", ""}, {"peng", "gh", ""},
+		{"peny", "", "s"},
+		{"pem", "bfv", ""}, {"pem", "", "p"},
+		{"pen", "cdjz", ""}, {"pen", "", "t"},
+		{"per", "bcdfghjklmnpstvwyz", ""},
+		{"pe", "bcdfghjklmnprstwyz", ""},
+		{"ber", "", ""}, {"ber", "bcdfghjklmnpstwyz", ""},
+		{"ke", "", ""},
+	}
+	for _, r := range rules {
+		if !strings.HasPrefix(s, r.prefix) {
+			continue
+		}
+		rest := s[len(r.prefix):]
+		if rest == "" {
+			continue
+		}
+		first := rune(rest[0])
+		if r.next == "" {
+			if !isVowel(first) {
+				continue
+			}
+		} else if !strings.ContainsRune(r.next, first) {
+			continue
+		}
+		root := r.restore + rest
+		if len([]rune(root)) < minStemRunes {
+			continue
+		}
+		return root, true
+	}
+	return s, false
+}
+
+func trimSuffix(s, suf string) (string, bool) {
+	if !strings.HasSuffix(s, suf) {
+		return s, false
+	}
+	cut := strings.TrimSuffix(s, suf)
+	if len([]rune(cut)) < minStemRunes {
+		return s, false
+	}
+	return cut, true
+}
+
+func isVowel(r rune) bool {
+	return strings.ContainsRune("aiueo", r)
+}
+
+func isLetters(w string) bool {
+	for _, r := range w {
+		if !unicode.IsLetter(r) {
+			return false
+		}
+	}
+	return true
+}
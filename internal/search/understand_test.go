@@ -0,0 +1,89 @@
+package search
+
+import (
+	"encoding/json"
+	"os"
+	"testing"
+)
+
+func TestProcessQueryUnderstanding(t *testing.T) {
+	raw, err := os.ReadFile("testdata/query_understanding.json")
+	if err != nil {
+		t.Fatal(err)
+	}
+	var cases []struct {
+		Input      string `json:"input"`
+		Query      string `json:"query"`
+		Translated string `json:"translated"`
+		Location   string `json:"location"`
+	}
+	if err := json.Unmarshal(raw, &cases); err != nil {
+		t.Fatal(err)
+	}
+
+	for _, tc := range cases {
+		got := ProcessQuery(tc.Input)
+		if got.Query != tc.Query || got.Translated != tc.Translated || got.Location != tc.Location {
+			t.Errorf("ProcessQuery(%q) = query %q, translated %q, location %q; want %q, %q, %q",
+				tc.Input, got.Query, got.Translated, got.Location, tc.Query, tc.Translated, tc.Location)
+		}
+		if tc.Translated != tc.Query && !containsString(got.Variants, tc.Translated) {
+			t.Errorf("ProcessQuery(%q).Variants = %v, missing %q", tc.Input, got.Variants, tc.Translated)
+		}
+	}
+}
+
+func TestProcessQueryKeepsWebsearchSyntax(t *testing.T) {
+	got := ProcessQuery(`"lowongan kerja" -jakarta`)
+	if got.Location != "" || got.Query != got.Normalized {
+		t.Fatalf("got query %q location %q, want the query untouched", got.Query, got.Location)
+	}
+	if got.TextQuery() != `"lowongan kerja" -jakarta` {
+		t.Fatalf("TextQuery() = %q", got.TextQuery())
+	}
+	if q := ProcessQuery("lowongan di bandung").TextQuery(); q != "" {
+		t.Fatalf("TextQuery() for a location-only query = %q, want empty", q)
+	}
+}
+
+func TestStemIndonesian(t *testing.T) {
+	cases := map[string]string{
+		"penjualan":  "jual",
+		"mengajar":   "ajar",
+		"pengajar":   "ajar",
+		"menulis":    "tulis",
+		"pemasaran":  "pasar",
+		"pelayan":    "layan",
+		"keuangan":   "uang",
+		"perancang":  "rancang",
+		"penerjemah": "terjemah",
+		"bermain":    "main",
+		"bukunya":    "buku",
+		"gudang":     "gudang",
+		"manager":    "manager",
+		"admin":      "admin",
+		"golang":     "golang",
+		"staf":       "staf",
+	}
+	for in, want := range cases {
+		if got := StemIndonesian(in); got != want {
+			t.Errorf("StemIndonesian(%q) = %q, want %q", in, got, want)
+		}
+	}
+}
+
+func TestDetectLanguage(t *testing.T) {
+	cases := map[string]string{
+		"lowongan kerja admin di jakarta": LanguageIndonesian,
+		"pengembang web":                  LanguageIndonesian,
+		"staf penjualan":                  LanguageIndonesian,
+		"jobs for web developer":          LanguageEnglish,
+		"backend developer":               "",
+		"":                                "",
+	}
+	for q, want := range cases {
+		if got := DetectLanguage(q); got != want {
+			t.Errorf("DetectLanguage(%q) = %q, want %q", q, got, want)
+		}
+	}
+}
@@ -7,6 +7,7 @@ import (
 	"time"
 
 	"skill-sync/internal/database"
+	"skill-sync/internal/domain/job"
 
 	"github.com/google/uuid"
 )
@@ -29,6 +30,7 @@ func (JobSeeder) Run(ctx context.Context, db database.DB) error {
 		"posted_at",
 		"scraped_at",
 		"source_url",
+		"seniority",
 		"created_at",
 	); err != nil {
 		return err
@@ -124,12 +126,15 @@ func (JobSeeder) Run(ctx context.Context, db database.DB) error {
 		externalID := buildExternalJobID(it.Title)
 
 		sourceURL := "https://www.linkedin.com/jobs/view/" + externalID
+		salary, _ := job.JobSalary(it.Title, it.Description)
 		_, err = db.Exec(ctx,
 			`INSERT INTO jobs (
 				id, source_id, external_job_id, title, company, location, employment_type,
-				description, raw_description, posted_at, scraped_at, source_url
+				description, raw_description, posted_at, scraped_at, source_url, seniority, location_id,
+				salary_min, salary_max, salary_currency, salary_period
 			)
-			VALUES ($1,$2,$3,$4,$5,$6,$7,$8,$9,$10,$11,$12)
+			VALUES ($1,$2,$3,$4,$5,$6,$7,$8,$9,$10,$11,$12,NULLIF($13, ''),NULLIF($14, ''),
+				NULLIF($15, 0),NULLIF($16, 0),NULLIF($17, ''),NULLIF($18, ''))
 			ON CONFLICT (source_id, external_job_id) DO NOTHING`,
 			id,
 			sourceID,
@@ -143,6 +148,12 @@ func (JobSeeder) Run(ctx context.Context, db database.DB) error {
 			now,
 			now,
 			sourceURL,
+			job.ClassifySeniority(it.Title, it.Description),
+			job.LocationID(it.Location),
+			salary.Min,
+			salary.Max,
+			salary.Currency,
+			salary.Period,
 		)
 		if err != nil {
 			continue
@@ -0,0 +1,348 @@
+package usecase
+
+import (
+	"context"
+	"errors"
+	"strings"
+	"time"
+
+	"skill-sync/internal/domain/matching"
+	"skill-sync/internal/repository"
+
+	"github.com/google/uuid"
+)
+
+var (
+	ErrApplicationNotFound = errors.New("application not found")
+	ErrApplicationExists   = errors.New("application already exists")
+)
+
+const (
+	StageSaved     = "saved"
+	StageApplied   = "applied"
+	StageScreening = "screening"
+	StageInterview = "interview"
+	StageOffer     = "offer"
+	StageRejected  = "rejected"
+	StageWithdrawn = "withdrawn"
+
+	maxApplicationNotesLength   = 5000
+	maxApplicationContactLength = 200
+)
+
+// ApplicationStages lists the stages in pipeline order.
+var ApplicationStages = []string{StageSaved, StageApplied, StageScreening, StageInterview, StageOffer, StageRejected, StageWithdrawn}
+
+type CreateApplicationInput struct {
+	JobID        uuid.UUID
+	Stage        string
+	Notes        string
+	ContactName  string
+	ContactEmail string
+	ContactPhone string
+	InterviewAt  *time.Time
+}
+
+// UpdateApplicationInput only changes the fields that are set.
+type UpdateApplicationInput struct {
+	Stage            *string
+	StageNote        string
+	Notes            *string
+	ContactName      *string
+	ContactEmail     *string
+	ContactPhone     *string
+	InterviewAt      *time.Time
+	ClearInterviewAt bool
+}
+
+type ApplicationItem struct {
+	repository.Application
+	// CurrentMatchScore is the user's fit today; ScoreChange compares it with
+	// the score when the job was saved.
+	CurrentMatchScore *int
+	ScoreChange       *int
+}
+
+type ApplicationSummary struct {
+	Total   int
+	ByStage []repository.ApplicationStageCount
+}
+
+type ApplicationUsecase interface {
+	Create(ctx context.Context, userID uuid.UUID, in CreateApplicationInput) (ApplicationItem, error)
+	Get(ctx context.Context, userID, id uuid.UUID) (ApplicationItem, error)
+	List(ctx context.Context, userID uuid.UUID, stage string) ([]ApplicationItem, error)
+	Update(ctx context.Context, userID, id uuid.UUID, in UpdateApplicationInput) (ApplicationItem, error)
+	Delete(ctx context.Context, userID, id uuid.UUID) error
+	History(ctx context.Context, userID, id uuid.UUID) ([]repository.ApplicationStageEvent, error)
+	Summary(ctx context.Context, userID uuid.UUID) (ApplicationSummary, error)
+}
+
+type matchCalculator interface {
+	CalculateMatchV2(ctx context.Context, userID, jobID uuid.UUID) (matching.ResultV2, error)
+}
+
+type ApplicationTracker struct {
+	repo    repository.ApplicationRepository
+	matcher matchCalculator
+}
+
+func NewApplicationUsecase(repo repository.ApplicationRepository, matcher matchCalculator) *ApplicationTracker {
+	return &ApplicationTracker{repo: repo, matcher: matcher}
+}
+
+func IsValidApplicationStage(stage string) bool {
+	for _, s := range ApplicationStages {
+		if s == stage {
+			return true
+		}
+	}
+	return false
+}
+
+func (u *ApplicationTracker) Create(ctx context.Context, userID uuid.UUID, in CreateApplicationInput) (ApplicationItem, error) {
+	if userID == uuid.Nil {
+		return ApplicationItem{}, ErrUnauthorized
+	}
+	if in.JobID == uuid.Nil {
+		return ApplicationItem{}, ErrInvalidInput
+	}
+	stage := strings.ToLower(strings.TrimSpace(in.Stage))
+	if stage == "" {
+		stage = StageSaved
+	}
+	if !IsValidApplicationStage(stage) {
+		return ApplicationItem{}, ErrInvalidInput
+	}
+
+	app := repository.Application{
+		UserID:       userID,
+		JobID:        in.JobID,
+		Stage:        stage,
+		Notes:        strings.TrimSpace(in.Notes),
+		ContactName:  strings.TrimSpace(in.ContactName),
+		ContactEmail: strings.TrimSpace(in.ContactEmail),
+		ContactPhone: strings.TrimSpace(in.ContactPhone),
+		InterviewAt:  in.InterviewAt,
+	}
+	if err := validateApplication(app); err != nil {
+		return ApplicationItem{}, err
+	}
+	if stageIsApplied(stage) {
+		now := time.Now()
+		app.AppliedAt = &now
+	}
+	if score, ok := u.currentScore(ctx, userID, in.JobID); ok {
+		app.SavedMatchScore = &score
+	}
+
+	id, err := u.repo.Create(ctx, app)
+	if err != nil {
+		if isUniqueViolation(err) {
+			return ApplicationItem{}, ErrApplicationExists
+		}
+		if isForeignKeyViolation(err) {
+			return ApplicationItem{}, ErrJobNotFound
+		}
+		return ApplicationItem{}, ErrInternal
+	}
+	return u.Get(ctx, userID, id)
+}
+
+func (u *ApplicationTracker) Get(ctx context.Context, userID, id uuid.UUID) (ApplicationItem, error) {
+	app, err := u.find(ctx, userID, id)
+	if err != nil {
+		return ApplicationItem{}, err
+	}
+	item := ApplicationItem{Application: app, CurrentMatchScore: app.StoredMatchScore}
+	if score, ok := u.currentScore(ctx, userID, app.JobID); ok {
+		item.CurrentMatchScore = &score
+	}
+	item.ScoreChange = scoreChange(app.SavedMatchScore, item.CurrentMatchScore)
+	return item, nil
+}
+
+// List uses the stored job_matches scores to avoid rescoring every entry.
+func (u *ApplicationTracker) List(ctx context.Context, userID uuid.UUID, stage string) ([]ApplicationItem, error) {
+	if userID == uuid.Nil {
+		return nil, ErrUnauthorized
+	}
+	stage = strings.ToLower(strings.TrimSpace(stage))
+	if stage != "" && !IsValidApplicationStage(stage) {
+		return nil, ErrInvalidInput
+	}
+
+	apps, err := u.repo.List(ctx, userID, stage)
+	if err != nil {
+		return nil, ErrInternal
+	}
+	out := make([]ApplicationItem, 0, len(apps))
+	for _, a := range apps {
+		out = append(out, ApplicationItem{
+			Application:       a,
+			CurrentMatchScore: a.StoredMatchScore,
+			ScoreChange:       scoreChange(a.SavedMatchScore, a.StoredMatchScore),
+		})
+	}
+	return out, nil
+}
+
+func (u *ApplicationTracker) Update(ctx context.Context, userID, id uuid.UUID, in UpdateApplicationInput) (ApplicationItem, error) {
+	app, err := u.find(ctx, userID, id)
+	if err != nil {
+		return ApplicationItem{}, err
+	}
+
+	if in.Stage != nil {
+		stage := strings.ToLower(strings.TrimSpace(*in.Stage))
+		if !IsValidApplicationStage(stage) {
+			return ApplicationItem{}, ErrInvalidInput
+		}
+		app.Stage = stage
+	}
+	if in.Notes != nil {
+		app.Notes = strings.TrimSpace(*in.Notes)
+	}
+	if in.ContactName != nil {
+		app.ContactName = strings.TrimSpace(*in.ContactName)
+	}
+	if in.ContactEmail != nil {
+		app.ContactEmail = strings.TrimSpace(*in.ContactEmail)
+	}
+	if in.ContactPhone != nil {
+		app.ContactPhone = strings.TrimSpace(*in.ContactPhone)
+	}
+	if in.ClearInterviewAt {
+		app.InterviewAt = nil
+	} else if in.InterviewAt != nil {
+		app.InterviewAt = in.InterviewAt
+	}
+	if err := validateApplication(app); err != nil {
+		return ApplicationItem{}, err
+	}
+	if app.AppliedAt == nil && stageIsApplied(app.Stage) {
+		now := time.Now()
+		app.AppliedAt = &now
+	}
+
+	stageNote := strings.TrimSpace(in.StageNote)
+	if len(stageNote) > maxApplicationNotesLength {
+		return ApplicationItem{}, ErrInvalidInput
+	}
+	ok, err := u.repo.Update(ctx, app, stageNote)
+	if err != nil {
+		return ApplicationItem{}, ErrInternal
+	}
+	if !ok {
+		return ApplicationItem{}, ErrApplicationNotFound
+	}
+	return u.Get(ctx, userID, id)
+}
+
+func (u *ApplicationTracker) Delete(ctx context.Context, userID, id uuid.UUID) error {
+	if userID == uuid.Nil {
+		return ErrUnauthorized
+	}
+	ok, err := u.repo.Delete(ctx, userID, id)
+	if err != nil {
+		return ErrInternal
+	}
+	if !ok {
+		return ErrApplicationNotFound
+	}
+	return nil
+}
+
+func (u *ApplicationTracker) History(ctx context.Context, userID, id uuid.UUID) ([]repository.ApplicationStageEvent, error) {
+	if _, err := u.find(ctx, userID, id); err != nil {
+		return nil, err
+	}
+	events, err := u.repo.ListStageEvents(ctx, id)
+	if err != nil {
+		return nil, ErrInternal
+	}
+	return events, nil
+}
+
+// Summary reports a count for every stage, including empty ones.
+func (u *ApplicationTracker) Summary(ctx context.Context, userID uuid.UUID) (ApplicationSummary, error) {
+	if userID == uuid.Nil {
+		return ApplicationSummary{}, ErrUnauthorized
+	}
+	counts, err := u.repo.CountByStage(ctx, userID)
+	if err != nil {
+		return ApplicationSummary{}, ErrInternal
+	}
+	byStage := make(map[string]int, len(counts))
+	for _, c := range counts {
+		byStage[c.Stage] = c.Count
+	}
+
+	out := ApplicationSummary{ByStage: make([]repository.ApplicationStageCount, 0, len(ApplicationStages))}
+	for _, s := range ApplicationStages {
+		out.ByStage = append(out.ByStage, repository.ApplicationStageCount{Stage: s, Count: byStage[s]})
+		out.Total += byStage[s]
+	}
+	return out, nil
+}
+
+func (u *ApplicationTracker) find(ctx context.Context, userID, id uuid.UUID) (repository.Application, error) {
+	if userID == uuid.Nil {
+		return repository.Application{}, ErrUnauthorized
+	}
+	app, ok, err := u.repo.FindByID(ctx, userID, id)
+	if err != nil {
+		return repository.Application{}, ErrInternal
+	}
+	if !ok {
+		return repository.Application{}, ErrApplicationNotFound
+	}
+	return app, nil
+}
+
+// currentScore is best effort: a user without skills or a job without
+// requirements simply has no score. Every requirement is reported as either
+// matched or missing, so an empty result means the job lists none.
+func (u *ApplicationTracker) currentScore(ctx context.Context, userID, jobID uuid.UUID) (int, bool) {
+	if u.matcher == nil {
+		return 0, false
+	}
+	res, err := u.matcher.CalculateMatchV2(ctx, userID, jobID)
+	if err != nil || len(res.MatchedSkills)+len(res.MissingSkills) == 0 {
+		return 0, false
+	}
+	return res.MatchScore, true
+}
+
+func validateApplication(app repository.Application) error {
+	if len(app.Notes) > maxApplicationNotesLength {
+		return ErrInvalidInput
+	}
+	for _, s := range []string{app.ContactName, app.ContactEmail, app.ContactPhone} {
+		if len(s) > maxApplicationContactLength {
+			return ErrInvalidInput
+		}
+	}
+	if app.ContactEmail != "" && !strings.Contains(app.ContactEmail, "@") {
+		return ErrInvalidInput
+	}
+	return nil
+}
+
+// stageIsApplied reports whether reaching the stage implies the user applied.
+func stageIsApplied(stage string) bool {
+	switch stage {
+	case StageApplied, StageScreening, StageInterview, StageOffer:
+		return true
+	default:
+		return false
+	}
+}
+
+func scoreChange(saved, current *int) *int {
+	if saved == nil || current == nil {
+		return nil
+	}
+	d := *current - *saved
+	return &d
+}
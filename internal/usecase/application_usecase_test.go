@@ -0,0 +1,107 @@
+package usecase
+
+import (
+	"context"
+	"testing"
+
+	"skill-sync/internal/domain/matching"
+	"skill-sync/internal/repository"
+
+	"github.com/google/uuid"
+)
+
+type stubApplicationRepo struct {
+	repository.ApplicationRepository
+	apps map[uuid.UUID]repository.Application
+}
+
+func (m *stubApplicationRepo) Create(_ context.Context, app repository.Application) (uuid.UUID, error) {
+	app.ID = uuid.New()
+	m.apps[app.ID] = app
+	return app.ID, nil
+}
+
+func (m *stubApplicationRepo) FindByID(_ context.Context, _ uuid.UUID, id uuid.UUID) (repository.Application, bool, error) {
+	app, ok := m.apps[id]
+	return app, ok, nil
+}
+
+type stubMatchCalculator struct {
+	res matching.ResultV2
+	err error
+}
+
+func (m *stubMatchCalculator) CalculateMatchV2(context.Context, uuid.UUID, uuid.UUID) (matching.ResultV2, error) {
+	return m.res, m.err
+}
+
+func TestApplicationTracker_Scoring(t *testing.T) {
+	userID, jobID := uuid.New(), uuid.New()
+	scored := matching.ResultV2{MatchScore: 60, MatchedSkills: []matching.MatchedSkillV2{{SkillID: uuid.New()}}}
+	intPtr := func(v int) *int { return &v }
+
+	cases := []struct {
+		name      string
+		res       matching.ResultV2
+		err       error
+		stored    *int
+		wantSaved *int
+		wantNow   *int
+	}{
+		{name: "job with requirements is scored", res: scored, wantSaved: intPtr(60), wantNow: intPtr(60)},
+		{name: "job without requirements has no score", res: matching.ResultV2{}, stored: intPtr(40), wantNow: intPtr(40)},
+		{name: "user without skills has no score", err: ErrUserSkillProfileEmpty},
+	}
+	for _, tc := range cases {
+		t.Run(tc.name, func(t *testing.T) {
+			repo := &stubApplicationRepo{apps: map[uuid.UUID]repository.Application{}}
+			matcher := &stubMatchCalculator{res: tc.res, err: tc.err}
+			uc := NewApplicationUsecase(repo, matcher)
+
+			item, err := uc.Create(context.Background(), userID, CreateApplicationInput{JobID: jobID})
+			if err != nil {
+				t.Fatalf("unexpected err: %v", err)
+			}
+			if !equalIntPtr(item.SavedMatchScore, tc.wantSaved) {
+				t.Fatalf("saved score = %v, want %v", item.SavedMatchScore, tc.wantSaved)
+			}
+
+			app := repo.apps[item.ID]
+			app.StoredMatchScore = tc.stored
+			repo.apps[item.ID] = app
+			got, err := uc.Get(context.Background(), userID, item.ID)
+			if err != nil {
+				t.Fatalf("unexpected err: %v", err)
+			}
+			if !equalIntPtr(got.CurrentMatchScore, tc.wantNow) {
+				t.Fatalf("current score = %v, want %v", got.CurrentMatchScore, tc.wantNow)
+			}
+		})
+	}
+
+	t.Run("score change compares with the saved score", func(t *testing.T) {
+		repo := &stubApplicationRepo{apps: map[uuid.UUID]repository.Application{}}
+		matcher := &stubMatchCalculator{res: scored}
+		uc := NewApplicationUsecase(repo, matcher)
+
+		item, err := uc.Create(context.Background(), userID, CreateApplicationInput{JobID: jobID})
+		if err != nil {
+			t.Fatalf("unexpected err: %v", err)
+		}
+		matcher.res.MatchScore = 75
+		got, err := uc.Get(context.Background(), userID, item.ID)
+		if err != nil {
+			t.Fatalf("unexpected err: %v", err)
+		}
+		if got.ScoreChange == nil || *got.ScoreChange != 15 {
+			t.Fatalf("score change = %v, want 15", got.ScoreChange)
+		}
+	})
+}
+
+func equalIntPtr(a, b *int) bool {
+	if a == nil || b == nil {
+		return a == b
+	}
+	return *a == *b
+}
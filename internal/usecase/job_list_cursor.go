@@ -0,0 +1,62 @@
+package usecase
+
+import (
+	"encoding/base64"
+	"encoding/json"
+	"time"
+
+	"skill-sync/internal/repository"
+
+	"github.com/google/uuid"
+)
+
+const (
+	TotalNone     = ""
+	TotalExact    = "exact"
+	TotalEstimate = "estimate"
+)
+
+// jobListCursor is the opaque /jobs page token. Besides the keyset position
+// it carries the title query the first page was widened to, so later pages
+// continue the corrected or fuzzy search instead of the query as typed.
+type jobListCursor struct {
+	Backward bool      `json:"b,omitempty"`
+	Rank     float64   `json:"r,omitempty"`
+	At       time.Time `json:"t"`
+	ID       uuid.UUID `json:"i"`
+	Query    string    `json:"q,omitempty"`
+	Fuzzy    bool      `json:"z,omitempty"`
+}
+
+func encodeJobListCursor(c jobListCursor) string {
+	b, _ := json.Marshal(c)
+	return base64.RawURLEncoding.EncodeToString(b)
+}
+
+func decodeJobListCursor(s string) (jobListCursor, error) {
+	var c jobListCursor
+	b, err := base64.RawURLEncoding.DecodeString(s)
+	if err != nil {
+		return c, ErrInvalidInput
+	}
+	if err := json.Unmarshal(b, &c); err != nil || c.ID == uuid.Nil || c.At.IsZero() {
+		return c, ErrInvalidInput
+	}
+	return c, nil
+}
+
+func (c jobListCursor) key() *repository.JobListKey {
+	return &repository.JobListKey{Rank: c.Rank, At: c.At, ID: c.ID, Backward: c.Backward}
+}
+
+func cursorAt(r repository.JobListRow, backward bool, res JobListResult) string {
+	k := r.ListingKey()
+	return encodeJobListCursor(jobListCursor{
+		Backward: backward,
+		Rank:     k.Rank,
+		At:       k.At,
+		ID:       k.ID,
+		Query:    res.SearchedQuery,
+		Fuzzy:    res.FuzzyMatch,
+	})
+}
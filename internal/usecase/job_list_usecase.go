@@ -3,9 +3,11 @@ package usecase
 import (
 	"context"
 	"log"
+	"sort"
 	"strings"
 	"time"
 
+	"skill-sync/internal/domain/job"
 	"skill-sync/internal/repository"
 	"skill-sync/internal/search"
 	"skill-sync/internal/service"
@@ -18,8 +20,44 @@ type JobListParams struct {
 	CompanyName string
 	Location    string
 	Skills      []string
+	Seniority   []string
 	Limit       int
 	Offset      int
+	// Cursor is a next_cursor/prev_cursor from an earlier page. It takes the
+	// place of Offset, which stays for older clients.
+	Cursor string
+	// Total asks for the number of matching jobs: TotalExact counts them,
+	// TotalEstimate uses the query planner's estimate.
+	Total string
+	// UserID, when set, hides the user's dismissed jobs and blocked companies.
+	UserID uuid.UUID
+	// Autocorrect re-runs a query that found few jobs with its "did you mean"
+	// suggestion and returns those results instead.
+	Autocorrect bool
+	// Multi-select facet filters; values within one facet are OR-ed. Posted
+	// takes repository.PostedBuckets values.
+	Locations       []string
+	Companies       []string
+	Sources         []string
+	EmploymentTypes []string
+	Posted          []string
+	// Facets adds value counts for each facet to the result.
+	Facets bool
+	// Personalize blends the user's skill match into the ranking of the page
+	// and reports it per job. It needs UserID.
+	Personalize bool
+	// Near limits results to jobs within RadiusKm of a city or district and
+	// ranks closer ones higher. RadiusKm defaults to defaultNearRadiusKm.
+	Near     string
+	RadiusKm float64
+	// SalaryMin and SalaryMax filter on salary in IDR per month; zero leaves
+	// that end open.
+	SalaryMin int
+	SalaryMax int
+	// Sort is "" for relevance and recency, or repository.JobSortSalary.
+	Sort string
+
+	exclusions repository.UserExclusions
 }
 
 type JobListItem struct {
@@ -30,40 +68,106 @@ type JobListItem struct {
 	SourceURL   string
 	Description string
 	Skills      []string
+	Seniority   string
 	PostedAt    *time.Time
+	// Score is the ranking breakdown behind the job's position on the page.
+	Score *search.JobScore
+	// MatchScore and MandatoryMissing are set on personalised results for
+	// jobs with skill requirements.
+	MatchScore       *int
+	MandatoryMissing *bool
+	// DistanceKm is set on near= searches.
+	DistanceKm *float64
+	// Salary is set when one was found in the posting.
+	Salary *job.Salary
+}
+
+type JobListResult struct {
+	Items   []JobListItem
+	Partial bool
+	// DidYouMean is a spelling suggestion, offered when the query found few jobs.
+	DidYouMean string
+	// CorrectedQuery is set when Items are the results for DidYouMean rather
+	// than for the query as typed.
+	CorrectedQuery string
+	// FuzzyMatch marks results found by title similarity rather than by
+	// full-text match.
+	FuzzyMatch bool
+	// SearchedQuery is the title query Items were found for when the search
+	// was widened; empty when it is the query as typed.
+	SearchedQuery string
+	// DetectedLocation is the place taken from the title query and used as
+	// the location filter, when no location was given.
+	DetectedLocation string
+	Facets           repository.JobFacets
+	// NextCursor and PrevCursor are empty when there is no such page.
+	NextCursor string
+	PrevCursor string
+	// Total is set when requested; TotalEstimated marks a planner estimate.
+	Total          *int
+	TotalEstimated bool
 }
 
 type JobListUsecase interface {
-	ListJobs(ctx context.Context, params JobListParams) ([]JobListItem, bool, error)
+	ListJobs(ctx context.Context, params JobListParams) (JobListResult, error)
 }
 
+// lowResultCount is the result count below which a title search is widened
+// with spelling correction and fuzzy matching.
+const lowResultCount = 5
+
+// facetValueLimit caps the values returned per facet.
+const facetValueLimit = 20
+
+const (
+	defaultNearRadiusKm = 25
+	maxNearRadiusKm     = 200
+)
+
 type freshnessEnsurer interface {
 	EnsureFresh(ctx context.Context, query, location string)
 }
 
 type JobList struct {
-	jobs      repository.JobRepository
-	jobSkills repository.JobSkillRepository
-	freshness freshnessEnsurer
-	cache     SearchCache
-	logger    *log.Logger
+	jobs       repository.JobRepository
+	jobSkills  repository.JobSkillRepository
+	freshness  freshnessEnsurer
+	cache      SearchCache
+	exclusions ExclusionProvider
+	speller    spellSuggester
+	matcher    jobMatcher
+	logger     *log.Logger
 }
 
-func NewJobListUsecase(jobs repository.JobRepository, jobSkills repository.JobSkillRepository, freshness freshnessEnsurer, cache SearchCache, logger *log.Logger) *JobList {
-	return &JobList{jobs: jobs, jobSkills: jobSkills, freshness: freshness, cache: cache, logger: logger}
+func NewJobListUsecase(jobs repository.JobRepository, jobSkills repository.JobSkillRepository, freshness freshnessEnsurer, cache SearchCache, exclusions ExclusionProvider, speller spellSuggester, matcher jobMatcher, logger *log.Logger) *JobList {
+	return &JobList{jobs: jobs, jobSkills: jobSkills, freshness: freshness, cache: cache, exclusions: exclusions, speller: speller, matcher: matcher, logger: logger}
 }
 
-func (u *JobList) ListJobs(ctx context.Context, params JobListParams) ([]JobListItem, bool, error) {
+func (u *JobList) ListJobs(ctx context.Context, params JobListParams) (JobListResult, error) {
 	limit := params.Limit
 	if limit == 0 {
 		limit = 20
 	}
 	if limit < 0 || limit > 50 {
-		return nil, false, ErrInvalidInput
+		return JobListResult{}, ErrInvalidInput
 	}
 	offset := params.Offset
 	if offset < 0 {
-		return nil, false, ErrInvalidInput
+		return JobListResult{}, ErrInvalidInput
+	}
+	if params.Total != TotalNone && params.Total != TotalExact && params.Total != TotalEstimate {
+		return JobListResult{}, ErrInvalidInput
+	}
+	var cur jobListCursor
+	if params.Cursor != "" {
+		if offset > 0 {
+			return JobListResult{}, ErrInvalidInput
+		}
+		c, err := decodeJobListCursor(params.Cursor)
+		if err != nil {
+			return JobListResult{}, err
+		}
+		cur = c
 	}
 
 	skills := make([]string, 0, len(params.Skills))
@@ -75,9 +179,52 @@ func (u *JobList) ListJobs(ctx context.Context, params JobListParams) ([]JobList
 		skills = append(skills, s)
 	}
 
+	seniority, err := normalizeSeniorityFilter(params.Seniority)
+	if err != nil {
+		return JobListResult{}, err
+	}
+
+	var excl repository.UserExclusions
+	if u != nil && u.exclusions != nil && params.UserID != uuid.Nil {
+		excl, err = u.exclusions.ListExclusions(ctx, params.UserID)
+		if err != nil {
+			return JobListResult{}, ErrInternal
+		}
+	}
+	params.exclusions = excl
+
+	center, near, radius, err := nearLocations(params.Near, params.RadiusKm)
+	if err != nil {
+		return JobListResult{}, err
+	}
+
+	if params.SalaryMin < 0 || params.SalaryMax < 0 || (params.SalaryMax > 0 && params.SalaryMin > params.SalaryMax) {
+		return JobListResult{}, ErrInvalidInput
+	}
+	sortBy := strings.ToLower(strings.TrimSpace(params.Sort))
+	if sortBy != "" && sortBy != repository.JobSortSalary {
+		return JobListResult{}, ErrInvalidInput
+	}
+
+	posted := normalizeFacetFilter(params.Posted)
+	for _, b := range posted {
+		if !repository.IsValidPostedBucket(b) {
+			return JobListResult{}, ErrInvalidInput
+		}
+	}
+
 	params.Limit = limit
 	params.Offset = offset
 	params.Skills = skills
+	params.Seniority = seniority
+	params.Locations = normalizeFacetFilter(params.Locations)
+	params.Companies = normalizeFacetFilter(params.Companies)
+	params.Sources = normalizeFacetFilter(params.Sources)
+	params.EmploymentTypes = normalizeFacetFilter(params.EmploymentTypes)
+	params.Posted = posted
+	params.Near = center.ID
+	params.RadiusKm = radius
+	params.Sort = sortBy
 
 	sp := service.SearchParams{
 		Title:       params.Title,
@@ -89,13 +236,48 @@ func (u *JobList) ListJobs(ctx context.Context, params JobListParams) ([]JobList
 	}
 
 	qctx := search.ProcessQuery(params.Title)
+	location := strings.TrimSpace(params.Location)
+	detectedLocation := ""
+	if location == "" && qctx.Location != "" {
+		location = qctx.Location
+		detectedLocation = qctx.Location
+	}
+	f := repository.JobListFilter{
+		Title:              qctx.Query,
+		TitleVariants:      qctx.Variants,
+		TextQuery:          qctx.TextQuery(),
+		CompanyName:        params.CompanyName,
+		Location:           location,
+		Skills:             skills,
+		Seniority:          seniority,
+		ExcludeJobIDs:      excl.JobIDs,
+		ExcludeCompanyKeys: excl.CompanyKeys,
+		Locations:          params.Locations,
+		Companies:          params.Companies,
+		Sources:            params.Sources,
+		EmploymentTypes:    params.EmploymentTypes,
+		PostedBuckets:      params.Posted,
+		Near:               near,
+		SalaryMin:          params.SalaryMin,
+		SalaryMax:          params.SalaryMax,
+		Sort:               sortBy,
+		Limit:              limit + 1,
+		Offset:             offset,
+	}
+	if params.Cursor != "" {
+		f.After = cur.key()
+		if cur.Query != "" {
+			f, qctx = withTitleQuery(f, cur.Query, cur.Fuzzy)
+		}
+	}
 
-	cacheable := sp.HasFilter()
+	cacheable := sp.HasFilter() || len(seniority) > 0 || hasFacetFilter(params) || len(near) > 0 ||
+		params.SalaryMin > 0 || params.SalaryMax > 0
 	cacheKey := ""
 	lockKey := ""
 	if u != nil && u.freshness != nil {
 		title := strings.TrimSpace(params.Title)
-		loc := strings.TrimSpace(params.Location)
+		loc := location
 		if loc == "" {
 			loc = "Indonesia"
 		}
@@ -108,13 +290,13 @@ func (u *JobList) ListJobs(ctx context.Context, params JobListParams) ([]JobList
 		lockKey = JobsSearchLockKey(cacheKey)
 
 		if u != nil && u.cache != nil {
-			var cached []JobListItem
+			var cached JobListResult
 			hit, err := u.cache.GetJSON(ctx, cacheKey, &cached)
 			if err == nil && hit {
 				if u.logger != nil {
 					u.logger.Printf("[Jobs] Cache HIT: %s", cacheKey)
 				}
-				return cached, false, nil
+				return u.complete(ctx, params, f, cacheable, cached)
 			}
 			if u.logger != nil {
 				u.logger.Printf("[Jobs] Cache MISS: %s", cacheKey)
@@ -135,13 +317,13 @@ func (u *JobList) ListJobs(ctx context.Context, params JobListParams) ([]JobList
 			jitterMs := time.Duration(time.Now().UnixNano()%201) * time.Millisecond
 			wait := 300*time.Millisecond + jitterMs
 			time.Sleep(wait)
-			var cached []JobListItem
+			var cached JobListResult
 			hit, err2 := u.cache.GetJSON(ctx, cacheKey, &cached)
 			if err2 == nil && hit {
 				if u.logger != nil {
 					u.logger.Printf("[Jobs] Cache HIT: %s", cacheKey)
 				}
-				return cached, false, nil
+				return u.complete(ctx, params, f, cacheable, cached)
 			}
 			if u.logger != nil {
 				u.logger.Printf("[Jobs] Lock wait fallback: %s", lockKey)
@@ -149,32 +331,51 @@ func (u *JobList) ListJobs(ctx context.Context, params JobListParams) ([]JobList
 		}
 	}
 
-	f := repository.JobListFilter{
-		Title:         params.Title,
-		TitleVariants: qctx.Variants,
-		CompanyName:   params.CompanyName,
-		Location:      params.Location,
-		Skills:        skills,
-		Limit:         limit,
-		Offset:        offset,
-	}
 	rows, err := u.jobs.ListJobsForListing(ctx, f)
 	if err != nil {
-		return nil, partial, ErrInternal
+		return JobListResult{}, ErrInternal
 	}
 
-	if len(rows) < 5 {
-		fb := search.FallbackFirstWord(qctx.Normalized)
-		if fb != "" && fb != qctx.Normalized {
-			fbCtx := search.ProcessQuery(fb)
-			f.TitleVariants = fbCtx.Variants
-			rows2, err2 := u.jobs.ListJobsForListing(ctx, f)
-			if err2 == nil {
-				rows = rows2
-			}
+	res := JobListResult{Partial: partial, SearchedQuery: cur.Query, FuzzyMatch: cur.Fuzzy, DetectedLocation: detectedLocation}
+	// Only the first page widens; later pages of an ordinary search that run
+	// short are just the end of it.
+	if len(rows) < lowResultCount && qctx.Query != "" && offset == 0 && params.Cursor == "" {
+		rows, qctx = u.widenSearch(ctx, f, qctx, params.Autocorrect, rows, &res)
+	}
+
+	// Rows come back in listing order with one extra row, at the end for
+	// forward pages and at the start for backward ones, which tells whether
+	// the page has a neighbour in that direction.
+	hasNext, hasPrev := false, offset > 0 || params.Cursor != ""
+	if cur.Backward {
+		hasNext = true
+		hasPrev = len(rows) > limit
+		if hasPrev {
+			rows = rows[1:]
+		}
+	} else if len(rows) > limit {
+		hasNext = true
+		rows = rows[:limit]
+	}
+	if len(rows) > 0 {
+		if hasNext {
+			res.NextCursor = cursorAt(rows[len(rows)-1], false, res)
+		}
+		if hasPrev {
+			res.PrevCursor = cursorAt(rows[0], true, res)
 		}
 	}
 
+	if params.Total != TotalNone {
+		n, err := u.jobs.CountJobsForListing(ctx, searchedFilter(f, res), params.Total == TotalExact)
+		if err != nil {
+			return JobListResult{}, ErrInternal
+		}
+		res.Total = &n
+		res.TotalEstimated = params.Total == TotalEstimate
+	}
+
+	scores := make(map[uuid.UUID]search.JobScore, len(rows))
 	if len(rows) > 0 {
 		rankInput := make([]search.Job, 0, len(rows))
 		for i := range rows {
@@ -190,11 +391,20 @@ func (u *JobList) ListJobs(ctx context.Context, params JobListParams) ([]JobList
 				Source:        r.Source,
 				CreatedAt:     r.CreatedAt,
 				PostedAt:      r.PostedAt,
+				TextRank:      r.TextRank,
+				SourceWeight:  r.SourceWeight,
+				DistanceKm:    r.DistanceKm,
+				RadiusKm:      radius,
 			})
 		}
 
-		ranked := search.RankJobs(rankInput, qctx.Variants)
-		if len(ranked) == len(rows) {
+		ranked, rankScores := search.RankJobs(rankInput, qctx.Variants)
+		for _, sc := range rankScores {
+			scores[sc.JobID] = sc
+		}
+		// An explicit sort keeps the database order; the scores are still
+		// reported.
+		if len(ranked) == len(rows) && sortBy == "" {
 			ordered := make([]repository.JobListRow, 0, len(rows))
 			for _, it := range ranked {
 				idx := it.OriginalIndex
@@ -219,7 +429,7 @@ func (u *JobList) ListJobs(ctx context.Context, params JobListParams) ([]JobList
 
 	reqsByJobID, err := u.jobSkills.FindByJobIDs(ctx, jobIDs)
 	if err != nil {
-		return nil, partial, ErrInternal
+		return JobListResult{}, ErrInternal
 	}
 
 	out := make([]JobListItem, 0, len(rows))
@@ -233,7 +443,7 @@ func (u *JobList) ListJobs(ctx context.Context, params JobListParams) ([]JobList
 			jobSkills = append(jobSkills, it.SkillName)
 		}
 
-		out = append(out, JobListItem{
+		item := JobListItem{
 			JobID:       r.ID,
 			Title:       r.Title,
 			CompanyName: r.Company,
@@ -241,12 +451,22 @@ func (u *JobList) ListJobs(ctx context.Context, params JobListParams) ([]JobList
 			SourceURL:   r.SourceURL,
 			Description: r.Description,
 			Skills:      jobSkills,
+			Seniority:   r.Seniority,
 			PostedAt:    r.PostedAt,
-		})
+			DistanceKm:  r.DistanceKm,
+			Salary:      r.Salary,
+		}
+		if sc, ok := scores[r.ID]; ok {
+			item.Score = &sc
+		}
+		out = append(out, item)
 	}
 
+	res.Items = out
+
 	if cacheable && u != nil && u.cache != nil {
-		_ = u.cache.SetJSON(ctx, cacheKey, out, 0)
+		_ = u.cache.SetJSON(ctx, cacheKey, res, 0)
+		_ = u.cache.IndexSearchKey(ctx, cacheKey, search.LookupKeys(qctx.Query), 0)
 		if u.logger != nil {
 			u.logger.Printf("[Jobs] Cache SET: %s", cacheKey)
 		}
@@ -254,5 +474,241 @@ func (u *JobList) ListJobs(ctx context.Context, params JobListParams) ([]JobList
 			_ = u.cache.Delete(ctx, lockKey)
 		}
 	}
-	return out, partial, nil
+	return u.complete(ctx, params, f, cacheable, res)
+}
+
+// complete adds the per-request parts to a shared, cacheable result.
+func (u *JobList) complete(ctx context.Context, params JobListParams, f repository.JobListFilter, cacheable bool, res JobListResult) (JobListResult, error) {
+	res, err := u.withFacets(ctx, params, f, cacheable, res)
+	if err != nil {
+		return JobListResult{}, err
+	}
+	return u.personalize(ctx, params, res)
+}
+
+// personalize re-ranks the page by blending each job's search score with the
+// user's match score. It runs after the cache so shared entries never hold
+// one user's ranking. Pages with an explicit sort keep their order.
+func (u *JobList) personalize(ctx context.Context, params JobListParams, res JobListResult) (JobListResult, error) {
+	if !params.Personalize || params.UserID == uuid.Nil || u.matcher == nil || len(res.Items) == 0 {
+		return res, nil
+	}
+	jobIDs := make([]uuid.UUID, 0, len(res.Items))
+	for _, it := range res.Items {
+		jobIDs = append(jobIDs, it.JobID)
+	}
+	matches, err := u.matcher.MatchJobs(ctx, params.UserID, jobIDs)
+	if err != nil {
+		return JobListResult{}, ErrInternal
+	}
+	if len(matches) == 0 {
+		return res, nil
+	}
+
+	items := make([]JobListItem, len(res.Items))
+	copy(items, res.Items)
+	for i := range items {
+		m, ok := matches[items[i].JobID]
+		if !ok {
+			continue
+		}
+		score, missing := m.MatchScore, m.MandatoryMissing
+		items[i].MatchScore = &score
+		items[i].MandatoryMissing = &missing
+		if items[i].Score != nil {
+			blended := search.BlendMatch(*items[i].Score, score)
+			items[i].Score = &blended
+		}
+	}
+	if params.Sort == "" {
+		sort.SliceStable(items, func(i, j int) bool {
+			return finalScore(items[i]) > finalScore(items[j])
+		})
+	}
+	res.Items = items
+	return res, nil
+}
+
+func finalScore(it JobListItem) float64 {
+	if it.Score == nil {
+		return 0
+	}
+	return it.Score.FinalScore
+}
+
+// withFacets attaches facet counts when requested. Counts cover the whole
+// filtered search, not just the page, so one cache entry serves every page;
+// it lives under jobs:search: and is invalidated with the result pages.
+func (u *JobList) withFacets(ctx context.Context, params JobListParams, f repository.JobListFilter, cacheable bool, res JobListResult) (JobListResult, error) {
+	if !params.Facets {
+		return res, nil
+	}
+	f = searchedFilter(f, res)
+
+	key := ""
+	if cacheable && u.cache != nil {
+		key = JobsSearchFacetsKey(params)
+		var cached repository.JobFacets
+		if hit, err := u.cache.GetJSON(ctx, key, &cached); err == nil && hit {
+			res.Facets = cached
+			return res, nil
+		}
+	}
+
+	facets, err := u.jobs.ListJobFacets(ctx, f, facetValueLimit)
+	if err != nil {
+		return JobListResult{}, ErrInternal
+	}
+	if key != "" {
+		_ = u.cache.SetJSON(ctx, key, facets, 0)
+		_ = u.cache.IndexSearchKey(ctx, key, search.LookupKeys(search.ProcessQuery(params.Title).Query), 0)
+	}
+	res.Facets = facets
+	return res, nil
+}
+
+// searchedFilter is the filter the result's jobs were found with, after any
+// widening of the title query.
+func searchedFilter(f repository.JobListFilter, res JobListResult) repository.JobListFilter {
+	if res.SearchedQuery != "" {
+		f, _ = withTitleQuery(f, res.SearchedQuery, res.FuzzyMatch)
+	}
+	return f
+}
+
+// withTitleQuery points the filter at another title query, matched by
+// full-text search or, when fuzzy, by title similarity.
+func withTitleQuery(f repository.JobListFilter, q string, fuzzy bool) (repository.JobListFilter, search.QueryContext) {
+	c := search.ProcessQuery(q)
+	f.Title = c.Query
+	f.TitleVariants = c.Variants
+	f.TextQuery = c.TextQuery()
+	f.FuzzyTitle = ""
+	if fuzzy {
+		f.TextQuery = ""
+		f.FuzzyTitle = c.Query
+	}
+	return f, c
+}
+
+// widenSearch handles a title query that found few jobs. It records a spelling
+// suggestion (and, with autocorrect, switches to its results), then falls back
+// to a trigram title match and finally to the query's first word. A fallback
+// only replaces rows when it finds more. The returned context is the query the
+// rows were found for, used for ranking.
+func (u *JobList) widenSearch(ctx context.Context, f repository.JobListFilter, qctx search.QueryContext, autocorrect bool, rows []repository.JobListRow, res *JobListResult) ([]repository.JobListRow, search.QueryContext) {
+	try := func(q string, fuzzy bool) (search.QueryContext, []repository.JobListRow, bool) {
+		nf, c := withTitleQuery(f, q, fuzzy)
+		more, err := u.jobs.ListJobsForListing(ctx, nf)
+		if err != nil || len(more) <= len(rows) {
+			return c, nil, false
+		}
+		res.SearchedQuery = c.Query
+		return c, more, true
+	}
+
+	if u.speller != nil {
+		if suggestion, ok := u.speller.Suggest(qctx.Query); ok {
+			res.DidYouMean = suggestion
+			if autocorrect {
+				if c, more, ok := try(suggestion, false); ok {
+					rows, qctx = more, c
+					res.CorrectedQuery = suggestion
+				}
+			}
+		}
+	}
+
+	if len(rows) < lowResultCount {
+		if c, more, ok := try(qctx.Query, true); ok {
+			rows, qctx = more, c
+			res.FuzzyMatch = true
+		}
+	}
+
+	if len(rows) < lowResultCount {
+		fb := search.FallbackFirstWord(qctx.Query)
+		if fb != "" && fb != qctx.Query {
+			if c, more, ok := try(fb, false); ok {
+				rows, qctx = more, c
+				res.FuzzyMatch = false
+			}
+		}
+	}
+	return rows, qctx
+}
+
+// nearLocations resolves a near= search to its centre and the gazetteer
+// locations within the radius. The centre must be a place with coordinates,
+// so "Remote" is rejected.
+func nearLocations(near string, radiusKm float64) (job.Location, []job.LocationDistance, float64, error) {
+	near = strings.TrimSpace(near)
+	if near == "" {
+		if radiusKm != 0 {
+			return job.Location{}, nil, 0, ErrInvalidInput
+		}
+		return job.Location{}, nil, 0, nil
+	}
+	if radiusKm == 0 {
+		radiusKm = defaultNearRadiusKm
+	}
+	if radiusKm < 0 || radiusKm > maxNearRadiusKm {
+		return job.Location{}, nil, 0, ErrInvalidInput
+	}
+	center, ok := job.ResolveLocation(near)
+	if !ok {
+		return job.Location{}, nil, 0, ErrInvalidInput
+	}
+	coords, ok := job.LocationCoordinates(center.ID)
+	if !ok {
+		return job.Location{}, nil, 0, ErrInvalidInput
+	}
+	return center, job.LocationsWithin(coords, radiusKm), radiusKm, nil
+}
+
+func hasFacetFilter(p JobListParams) bool {
+	return len(p.Locations) > 0 || len(p.Companies) > 0 || len(p.Sources) > 0 || len(p.EmploymentTypes) > 0 || len(p.Posted) > 0
+}
+
+// normalizeFacetFilter lower-cases, de-duplicates and sorts facet values so
+// equivalent selections share a cache entry.
+func normalizeFacetFilter(in []string) []string {
+	seen := map[string]struct{}{}
+	out := make([]string, 0, len(in))
+	for _, v := range in {
+		v = normalizeSearchValue(v)
+		if v == "" {
+			continue
+		}
+		if _, ok := seen[v]; ok {
+			continue
+		}
+		seen[v] = struct{}{}
+		out = append(out, v)
+	}
+	sort.Strings(out)
+	return out
+}
+
+// normalizeSeniorityFilter accepts level names and their cues ("magang",
+// "fresh graduate") and returns a sorted, de-duplicated list of levels.
+func normalizeSeniorityFilter(in []string) ([]string, error) {
+	seen := map[string]struct{}{}
+	out := make([]string, 0, len(in))
+	for _, raw := range in {
+		if strings.TrimSpace(raw) == "" {
+			continue
+		}
+		level := job.ParseSeniority(raw)
+		if level == "" {
+			return nil, ErrInvalidInput
+		}
+		if _, ok := seen[level]; ok {
+			continue
+		}
+		seen[level] = struct{}{}
+		out = append(out, level)
+	}
+	sort.Slice(out, func(i, j int) bool { return job.SeniorityRank(out[i]) < job.SeniorityRank(out[j]) })
+	return out, nil
 }
@@ -3,9 +3,12 @@ package usecase
 import (
 	"context"
 	"errors"
+	"strings"
 	"testing"
 	"time"
 
+	"skill-sync/internal/domain/job"
+	"skill-sync/internal/domain/matching"
 	"skill-sync/internal/repository"
 
 	"github.com/google/uuid"
@@ -18,6 +21,9 @@ type mockJobRepo struct {
 
 func (m mockJobRepo) ExistsByID(context.Context, uuid.UUID) (bool, error)          { return false, nil }
 func (m mockJobRepo) ListJobs(context.Context, int, int) ([]repository.Job, error) { return nil, nil }
+func (m mockJobRepo) FindByIDs(context.Context, []uuid.UUID) ([]repository.Job, error) {
+	return nil, nil
+}
 
 func (m mockJobRepo) GetLatestScrapedAt(context.Context, string, string) (time.Time, error) {
 	return time.Time{}, nil
@@ -28,6 +34,12 @@ func (m mockJobRepo) ListActiveJobsWithoutSkills(context.Context, int, int) ([]r
 func (m mockJobRepo) ListJobsForListing(context.Context, repository.JobListFilter) ([]repository.JobListRow, error) {
 	return m.items, m.err
 }
+func (m mockJobRepo) ListJobFacets(context.Context, repository.JobListFilter, int) (repository.JobFacets, error) {
+	return repository.JobFacets{}, nil
+}
+func (m mockJobRepo) CountJobsForListing(context.Context, repository.JobListFilter, bool) (int, error) {
+	return len(m.items), nil
+}
 func (m mockJobRepo) UpsertJobs(context.Context, []repository.JobUpsert) error { return nil }
 
 type mockJobSkillRepo struct {
@@ -46,8 +58,8 @@ func (m mockJobSkillRepo) FindByJobIDs(context.Context, []uuid.UUID) (map[uuid.U
 }
 
 func TestJobListUsecase_ListJobs_InvalidLimit(t *testing.T) {
-	uc := NewJobListUsecase(mockJobRepo{}, mockJobSkillRepo{}, nil, nil, nil)
-	_, _, err := uc.ListJobs(context.Background(), JobListParams{Limit: -1, Offset: 0})
+	uc := NewJobListUsecase(mockJobRepo{}, mockJobSkillRepo{}, nil, nil, nil, nil, nil, nil)
+	_, err := uc.ListJobs(context.Background(), JobListParams{Limit: -1, Offset: 0})
 	if !errors.Is(err, ErrInvalidInput) {
 		t.Fatalf("expected ErrInvalidInput, got %v", err)
 	}
@@ -73,13 +85,17 @@ func TestJobListUsecase_ListJobs_Success(t *testing.T) {
 		nil,
 		nil,
 		nil,
+		nil,
+		nil,
+		nil,
 	)
 
-	items, partial, err := uc.ListJobs(context.Background(), JobListParams{Limit: 20, Offset: 0})
+	res, err := uc.ListJobs(context.Background(), JobListParams{Limit: 20, Offset: 0})
 	if err != nil {
 		t.Fatalf("unexpected err: %v", err)
 	}
-	if partial {
+	items := res.Items
+	if res.Partial {
 		t.Fatalf("expected partial=false")
 	}
 	if len(items) != 1 {
@@ -95,3 +111,316 @@ func TestJobListUsecase_ListJobs_Success(t *testing.T) {
 		t.Fatalf("expected 2 skills, got %d", len(items[0].Skills))
 	}
 }
+
+type queryJobRepo struct {
+	mockJobRepo
+	list func(repository.JobListFilter) []repository.JobListRow
+}
+
+func (m queryJobRepo) ListJobsForListing(_ context.Context, f repository.JobListFilter) ([]repository.JobListRow, error) {
+	return m.list(f), nil
+}
+
+type facetJobRepo struct {
+	queryJobRepo
+	facets func(repository.JobListFilter) repository.JobFacets
+}
+
+func (m facetJobRepo) ListJobFacets(_ context.Context, f repository.JobListFilter, _ int) (repository.JobFacets, error) {
+	return m.facets(f), nil
+}
+
+type stubMatcher map[uuid.UUID]int
+
+func (m stubMatcher) MatchJobs(_ context.Context, _ uuid.UUID, jobIDs []uuid.UUID) (map[uuid.UUID]matching.ResultV2, error) {
+	out := map[uuid.UUID]matching.ResultV2{}
+	for _, id := range jobIDs {
+		if score, ok := m[id]; ok {
+			out[id] = matching.ResultV2{MatchScore: score, MandatoryMissing: score < 50}
+		}
+	}
+	return out, nil
+}
+
+type stubSpeller map[string]string
+
+func (s stubSpeller) Suggest(q string) (string, bool) {
+	v, ok := s[q]
+	return v, ok
+}
+
+func TestJobListUsecase_ListJobs_DidYouMean(t *testing.T) {
+	jobID := uuid.New()
+	repo := queryJobRepo{list: func(f repository.JobListFilter) []repository.JobListRow {
+		if strings.Contains(f.TextQuery, "programmer") {
+			return []repository.JobListRow{{ID: jobID, Title: "Programmer"}}
+		}
+		return nil
+	}}
+	uc := NewJobListUsecase(repo, mockJobSkillRepo{}, nil, nil, nil, stubSpeller{"progammer": "programmer"}, nil, nil)
+
+	res, err := uc.ListJobs(context.Background(), JobListParams{Title: "progammer"})
+	if err != nil {
+		t.Fatalf("unexpected err: %v", err)
+	}
+	if res.DidYouMean != "programmer" || res.CorrectedQuery != "" || len(res.Items) != 0 {
+		t.Fatalf("expected suggestion only, got %+v", res)
+	}
+
+	res, err = uc.ListJobs(context.Background(), JobListParams{Title: "progammer", Autocorrect: true})
+	if err != nil {
+		t.Fatalf("unexpected err: %v", err)
+	}
+	if res.CorrectedQuery != "programmer" || len(res.Items) != 1 || res.Items[0].JobID != jobID {
+		t.Fatalf("expected corrected results, got %+v", res)
+	}
+}
+
+func TestJobListUsecase_ListJobs_FuzzyFallback(t *testing.T) {
+	repo := queryJobRepo{list: func(f repository.JobListFilter) []repository.JobListRow {
+		if f.FuzzyTitle == "backedn" {
+			return []repository.JobListRow{{ID: uuid.New(), Title: "Backend Engineer"}}
+		}
+		return nil
+	}}
+	uc := NewJobListUsecase(repo, mockJobSkillRepo{}, nil, nil, nil, nil, nil, nil)
+
+	res, err := uc.ListJobs(context.Background(), JobListParams{Title: "Backedn"})
+	if err != nil {
+		t.Fatalf("unexpected err: %v", err)
+	}
+	if !res.FuzzyMatch || len(res.Items) != 1 {
+		t.Fatalf("expected fuzzy results, got %+v", res)
+	}
+}
+
+func TestJobListUsecase_ListJobs_NoFallbackPastFirstPage(t *testing.T) {
+	var fuzzy bool
+	lastID := uuid.New()
+	repo := queryJobRepo{list: func(f repository.JobListFilter) []repository.JobListRow {
+		if f.FuzzyTitle != "" {
+			fuzzy = true
+			return []repository.JobListRow{{ID: uuid.New(), Title: "Backend Engineer"}}
+		}
+		return []repository.JobListRow{{ID: lastID, Title: "Backend Developer"}}
+	}}
+	uc := NewJobListUsecase(repo, mockJobSkillRepo{}, nil, nil, nil, stubSpeller{"backend": "backends"}, nil, nil)
+
+	res, err := uc.ListJobs(context.Background(), JobListParams{Title: "backend", Offset: 20})
+	if err != nil {
+		t.Fatalf("unexpected err: %v", err)
+	}
+	if fuzzy || res.FuzzyMatch || res.DidYouMean != "" || len(res.Items) != 1 || res.Items[0].JobID != lastID {
+		t.Fatalf("expected the plain last page, got %+v", res)
+	}
+}
+
+func TestJobListUsecase_ListJobs_Facets(t *testing.T) {
+	var listed, counted repository.JobListFilter
+	repo := facetJobRepo{
+		queryJobRepo: queryJobRepo{list: func(f repository.JobListFilter) []repository.JobListRow {
+			listed = f
+			return nil
+		}},
+		facets: func(f repository.JobListFilter) repository.JobFacets {
+			counted = f
+			return repository.JobFacets{repository.FacetLocation: {{Value: "Jakarta", Count: 3}}}
+		},
+	}
+	uc := NewJobListUsecase(repo, mockJobSkillRepo{}, nil, nil, nil, nil, nil, nil)
+
+	res, err := uc.ListJobs(context.Background(), JobListParams{
+		Locations: []string{" Jakarta ", "bandung", "jakarta"},
+		Posted:    []string{"7d"},
+		Facets:    true,
+	})
+	if err != nil {
+		t.Fatalf("unexpected err: %v", err)
+	}
+	if got := listed.Locations; len(got) != 2 || got[0] != "bandung" || got[1] != "jakarta" {
+		t.Fatalf("unexpected location filter %v", got)
+	}
+	if len(counted.PostedBuckets) != 1 || counted.Limit != listed.Limit {
+		t.Fatalf("expected facets over the listing filter, got %+v", counted)
+	}
+	if len(res.Facets[repository.FacetLocation]) != 1 {
+		t.Fatalf("expected facets, got %+v", res.Facets)
+	}
+
+	_, err = uc.ListJobs(context.Background(), JobListParams{Posted: []string{"yesterday"}})
+	if !errors.Is(err, ErrInvalidInput) {
+		t.Fatalf("expected ErrInvalidInput, got %v", err)
+	}
+}
+
+func TestJobListUsecase_ListJobs_Cursor(t *testing.T) {
+	base := time.Date(2026, 1, 10, 0, 0, 0, 0, time.UTC)
+	all := make([]repository.JobListRow, 0, 5)
+	for i := 0; i < 5; i++ {
+		all = append(all, repository.JobListRow{ID: uuid.New(), Title: "Job", CreatedAt: base.Add(-time.Duration(i) * time.Hour)})
+	}
+	var got []repository.JobListFilter
+	repo := queryJobRepo{list: func(f repository.JobListFilter) []repository.JobListRow {
+		got = append(got, f)
+		rows := all
+		if f.After != nil {
+			for i, r := range all {
+				if r.ID == f.After.ID {
+					if f.After.Backward {
+						rows = all[max(0, i-f.Limit):i]
+					} else {
+						rows = all[i+1:]
+					}
+				}
+			}
+		}
+		if len(rows) > f.Limit {
+			rows = rows[:f.Limit]
+		}
+		return rows
+	}}
+	uc := NewJobListUsecase(repo, mockJobSkillRepo{}, nil, nil, nil, nil, nil, nil)
+
+	first, err := uc.ListJobs(context.Background(), JobListParams{Limit: 2, Total: TotalExact})
+	if err != nil {
+		t.Fatalf("unexpected err: %v", err)
+	}
+	if len(first.Items) != 2 || first.NextCursor == "" || first.PrevCursor != "" {
+		t.Fatalf("unexpected first page %+v", first)
+	}
+	if first.Total == nil || first.TotalEstimated {
+		t.Fatalf("expected exact total")
+	}
+
+	second, err := uc.ListJobs(context.Background(), JobListParams{Limit: 2, Cursor: first.NextCursor})
+	if err != nil {
+		t.Fatalf("unexpected err: %v", err)
+	}
+	if len(second.Items) != 2 || second.Items[0].JobID != all[2].ID || second.PrevCursor == "" {
+		t.Fatalf("unexpected second page %+v", second)
+	}
+	if last := got[len(got)-1]; last.After == nil || last.After.ID != all[1].ID {
+		t.Fatalf("expected keyset after the first page, got %+v", last.After)
+	}
+
+	back, err := uc.ListJobs(context.Background(), JobListParams{Limit: 2, Cursor: second.PrevCursor})
+	if err != nil {
+		t.Fatalf("unexpected err: %v", err)
+	}
+	if len(back.Items) != 2 || back.Items[0].JobID != all[0].ID || back.PrevCursor != "" || back.NextCursor == "" {
+		t.Fatalf("unexpected previous page %+v", back)
+	}
+
+	if _, err := uc.ListJobs(context.Background(), JobListParams{Offset: 2, Cursor: first.NextCursor}); !errors.Is(err, ErrInvalidInput) {
+		t.Fatalf("expected ErrInvalidInput for cursor with offset, got %v", err)
+	}
+	if _, err := uc.ListJobs(context.Background(), JobListParams{Cursor: "not-a-cursor"}); !errors.Is(err, ErrInvalidInput) {
+		t.Fatalf("expected ErrInvalidInput for bad cursor, got %v", err)
+	}
+}
+
+func TestJobListUsecase_ListJobs_Personalize(t *testing.T) {
+	now := time.Now().UTC()
+	weak, strong := uuid.New(), uuid.New()
+	repo := mockJobRepo{items: []repository.JobListRow{
+		{ID: weak, Title: "Backend Engineer", CreatedAt: now},
+		{ID: strong, Title: "Backend Engineer", CreatedAt: now},
+	}}
+	uc := NewJobListUsecase(repo, mockJobSkillRepo{}, nil, nil, nil, nil, stubMatcher{weak: 10, strong: 95}, nil)
+
+	plain, err := uc.ListJobs(context.Background(), JobListParams{Title: "backend", UserID: uuid.New()})
+	if err != nil {
+		t.Fatalf("unexpected err: %v", err)
+	}
+	if plain.Items[0].JobID != weak || plain.Items[0].MatchScore != nil {
+		t.Fatalf("expected search order without match data, got %+v", plain.Items)
+	}
+
+	res, err := uc.ListJobs(context.Background(), JobListParams{Title: "backend", UserID: uuid.New(), Personalize: true})
+	if err != nil {
+		t.Fatalf("unexpected err: %v", err)
+	}
+	top := res.Items[0]
+	if top.JobID != strong || top.MatchScore == nil || *top.MatchScore != 95 || *top.MandatoryMissing {
+		t.Fatalf("expected the stronger match first, got %+v", res.Items)
+	}
+	if top.Score == nil || top.Score.Match != 9.5 {
+		t.Fatalf("expected match blended into the score, got %+v", top.Score)
+	}
+}
+
+func TestJobListUsecase_ListJobs_Near(t *testing.T) {
+	far, near := 24.0, 3.0
+	farID, nearID := uuid.New(), uuid.New()
+	now := time.Now()
+	var listed repository.JobListFilter
+	repo := queryJobRepo{list: func(f repository.JobListFilter) []repository.JobListRow {
+		listed = f
+		return []repository.JobListRow{
+			{ID: farID, Title: "Admin", Location: "Bekasi", CreatedAt: now, DistanceKm: &far},
+			{ID: nearID, Title: "Admin", Location: "Jakarta Pusat", CreatedAt: now, DistanceKm: &near},
+		}
+	}}
+	uc := NewJobListUsecase(repo, mockJobSkillRepo{}, nil, nil, nil, nil, nil, nil)
+
+	res, err := uc.ListJobs(context.Background(), JobListParams{Near: "Jakarta", RadiusKm: 30})
+	if err != nil {
+		t.Fatalf("unexpected err: %v", err)
+	}
+	ids := map[string]bool{}
+	for _, n := range listed.Near {
+		ids[n.ID] = true
+	}
+	if !ids["dki-jakarta"] || !ids["jawa-barat/bekasi"] || ids["jawa-barat/bandung"] {
+		t.Fatalf("unexpected near filter %+v", listed.Near)
+	}
+	if len(res.Items) != 2 || res.Items[0].JobID != nearID || res.Items[0].DistanceKm == nil || *res.Items[0].DistanceKm != near {
+		t.Fatalf("expected the closer job first with its distance, got %+v", res.Items)
+	}
+
+	for _, p := range []JobListParams{
+		{Near: "Atlantis"},
+		{Near: "Remote"},
+		{Near: "Bandung", RadiusKm: 500},
+		{RadiusKm: 10},
+	} {
+		if _, err := uc.ListJobs(context.Background(), p); !errors.Is(err, ErrInvalidInput) {
+			t.Fatalf("ListJobs(%+v) err = %v, want ErrInvalidInput", p, err)
+		}
+	}
+}
+
+func TestJobListUsecase_ListJobs_SalarySort(t *testing.T) {
+	now := time.Now()
+	paidID, freshID := uuid.New(), uuid.New()
+	var listed repository.JobListFilter
+	repo := queryJobRepo{list: func(f repository.JobListFilter) []repository.JobListRow {
+		listed = f
+		return []repository.JobListRow{
+			{ID: paidID, Title: "Admin", CreatedAt: now.AddDate(0, -2, 0), Salary: &job.Salary{Min: 12_000_000, Max: 15_000_000, Currency: "IDR", Period: job.SalaryPeriodMonth}},
+			{ID: freshID, Title: "Admin", Location: "Jakarta", Description: "Kelola dokumen kantor", CreatedAt: now, Salary: &job.Salary{Min: 6_000_000, Max: 8_000_000, Currency: "IDR", Period: job.SalaryPeriodMonth}},
+		}
+	}}
+	uc := NewJobListUsecase(repo, mockJobSkillRepo{}, nil, nil, nil, nil, nil, nil)
+
+	res, err := uc.ListJobs(context.Background(), JobListParams{SalaryMin: 5_000_000, Sort: "Salary"})
+	if err != nil {
+		t.Fatalf("unexpected err: %v", err)
+	}
+	if listed.SalaryMin != 5_000_000 || listed.Sort != repository.JobSortSalary {
+		t.Fatalf("unexpected filter %+v", listed)
+	}
+	if len(res.Items) != 2 || res.Items[0].JobID != paidID || res.Items[0].Salary == nil || res.Items[0].Salary.Max != 15_000_000 {
+		t.Fatalf("expected the database salary order to be kept, got %+v", res.Items)
+	}
+
+	for _, p := range []JobListParams{
+		{SalaryMin: -1},
+		{SalaryMin: 10_000_000, SalaryMax: 5_000_000},
+		{Sort: "price"},
+	} {
+		if _, err := uc.ListJobs(context.Background(), p); !errors.Is(err, ErrInvalidInput) {
+			t.Fatalf("ListJobs(%+v) err = %v, want ErrInvalidInput", p, err)
+		}
+	}
+}
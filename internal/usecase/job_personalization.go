@@ -0,0 +1,75 @@
+package usecase
+
+import (
+	"context"
+
+	"skill-sync/internal/domain/matching"
+	"skill-sync/internal/repository"
+
+	"github.com/google/uuid"
+)
+
+type jobMatcher interface {
+	MatchJobs(ctx context.Context, userID uuid.UUID, jobIDs []uuid.UUID) (map[uuid.UUID]matching.ResultV2, error)
+}
+
+// JobPersonalizer scores a page of search results against a user's skill
+// profile with the V2 engine, for personalize=true on /jobs.
+type JobPersonalizer struct {
+	userSkills  repository.UserSkillRepository
+	jobSkillsV2 repository.JobSkillV2Repository
+	seniority   repository.SeniorityRepository
+	engines     MatchEngineProvider
+}
+
+func NewJobPersonalizer(userSkills repository.UserSkillRepository, jobSkillsV2 repository.JobSkillV2Repository, seniority repository.SeniorityRepository, engines MatchEngineProvider) *JobPersonalizer {
+	return &JobPersonalizer{userSkills: userSkills, jobSkillsV2: jobSkillsV2, seniority: seniority, engines: engines}
+}
+
+// MatchJobs returns the user's match against each job. Jobs without skill
+// requirements are left out, as is everything when the user has no skills.
+func (p *JobPersonalizer) MatchJobs(ctx context.Context, userID uuid.UUID, jobIDs []uuid.UUID) (map[uuid.UUID]matching.ResultV2, error) {
+	out := make(map[uuid.UUID]matching.ResultV2, len(jobIDs))
+	if userID == uuid.Nil || len(jobIDs) == 0 {
+		return out, nil
+	}
+
+	us, err := p.userSkills.FindByUserID(ctx, userID)
+	if err != nil {
+		return nil, ErrInternal
+	}
+	userSkills := toEngineUserSkills(us)
+	if len(userSkills) == 0 {
+		return out, nil
+	}
+
+	reqsByJob, err := p.jobSkillsV2.FindByJobIDsV2(ctx, jobIDs)
+	if err != nil {
+		return nil, ErrInternal
+	}
+
+	userRank := userSeniorityRank(ctx, p.seniority, userID)
+	jobRanks := map[uuid.UUID]int{}
+	if userRank > 0 {
+		jobRanks = jobSeniorityRanks(ctx, p.seniority, jobIDs)
+	}
+
+	score := func(id uuid.UUID, reqs []matching.JobRequirementV2) matching.ResultV2 {
+		return matching.CalculateV2(userSkills, reqs)
+	}
+	if p.engines != nil {
+		engine := p.engines.Engine()
+		score = func(id uuid.UUID, reqs []matching.JobRequirementV2) matching.ResultV2 {
+			return engine.ScoreWithSeniority(userSkills, reqs, userRank, jobRanks[id])
+		}
+	}
+
+	for _, id := range jobIDs {
+		reqs := toEngineRequirements(reqsByJob[id])
+		if len(reqs) == 0 {
+			continue
+		}
+		out[id] = score(id, reqs)
+	}
+	return out, nil
+}
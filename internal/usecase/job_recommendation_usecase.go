@@ -4,9 +4,11 @@ import (
 	"context"
 	"errors"
 	"sort"
+	"time"
 
 	"skill-sync/internal/domain/matching"
 	"skill-sync/internal/repository"
+	"skill-sync/internal/search"
 
 	"github.com/google/uuid"
 )
@@ -15,10 +17,23 @@ var (
 	ErrNoJobsFound = errors.New("No jobs found")
 )
 
+const (
+	// recommendationTopK bounds how deep recommendations can be paginated.
+	recommendationTopK = 500
+	// recommendationCandidates caps the jobs pulled from the skill index.
+	recommendationCandidates = 2000
+
+	defaultRecommendationMatchTTL = 24 * time.Hour
+)
+
 type JobRecommendationParams struct {
 	Limit    int
 	Offset   int
 	MinScore int
+	// Diversity in 0..1 trades match score for variety of company, title and
+	// location; 0 keeps the pure score order.
+	Diversity     float64
+	MaxPerCompany int
 }
 
 type JobRecommendationUsecase interface {
@@ -32,17 +47,57 @@ type JobRecommendationItem struct {
 	Location         string
 	MatchScore       int
 	MandatoryMissing bool
-	MissingSkills    []matching.MissingSkill
+	MissingSkills    []matching.MissingSkillV2
+	Rank             int
+	OriginalRank     int
+	RerankReason     string
 }
 
 type JobRecommendation struct {
-	jobs       repository.JobRepository
-	jobSkills  repository.JobSkillRepository
-	userSkills repository.UserSkillRepository
+	jobs        repository.JobRepository
+	jobsQry     repository.JobQueryRepository
+	jobSkillsV2 repository.JobSkillV2Repository
+	userSkills  repository.UserSkillRepository
+	matches     repository.JobMatchRepository
+	dirty       repository.MatchDirtyRepository
+	seniority   repository.SeniorityRepository
+	engines     MatchEngineProvider
+	exclusions  ExclusionProvider
+	matchTTL    time.Duration
+}
+
+func NewJobRecommendationUsecase(
+	jobs repository.JobRepository,
+	jobsQry repository.JobQueryRepository,
+	jobSkillsV2 repository.JobSkillV2Repository,
+	userSkills repository.UserSkillRepository,
+	matches repository.JobMatchRepository,
+	dirty repository.MatchDirtyRepository,
+	seniority repository.SeniorityRepository,
+	engines MatchEngineProvider,
+	exclusions ExclusionProvider,
+	matchTTL time.Duration,
+) *JobRecommendation {
+	if matchTTL <= 0 {
+		matchTTL = defaultRecommendationMatchTTL
+	}
+	return &JobRecommendation{
+		jobs:        jobs,
+		jobsQry:     jobsQry,
+		jobSkillsV2: jobSkillsV2,
+		userSkills:  userSkills,
+		matches:     matches,
+		dirty:       dirty,
+		seniority:   seniority,
+		engines:     engines,
+		exclusions:  exclusions,
+		matchTTL:    matchTTL,
+	}
 }
 
-func NewJobRecommendationUsecase(jobs repository.JobRepository, jobSkills repository.JobSkillRepository, userSkills repository.UserSkillRepository) *JobRecommendation {
-	return &JobRecommendation{jobs: jobs, jobSkills: jobSkills, userSkills: userSkills}
+type rankedJob struct {
+	id     uuid.UUID
+	result matching.ResultV2
 }
 
 func (u *JobRecommendation) GetRecommendations(ctx context.Context, userID uuid.UUID, params JobRecommendationParams) ([]JobRecommendationItem, error) {
@@ -65,6 +120,38 @@ func (u *JobRecommendation) GetRecommendations(ctx context.Context, userID uuid.
 	if minScore < 0 {
 		minScore = 0
 	}
+	diversity := params.Diversity
+	if diversity < 0 {
+		diversity = 0
+	}
+	if diversity > 1 {
+		diversity = 1
+	}
+	maxPerCompany := params.MaxPerCompany
+	if maxPerCompany < 0 {
+		maxPerCompany = 0
+	}
+	rerank := diversity > 0 || maxPerCompany > 0
+
+	if offset >= recommendationTopK {
+		return nil, ErrNoJobsFound
+	}
+
+	var excl repository.UserExclusions
+	if u.exclusions != nil {
+		loaded, err := u.exclusions.ListExclusions(ctx, userID)
+		if err != nil {
+			return nil, ErrInternal
+		}
+		excl = loaded
+	}
+	// Filtering and re-ranking work on the full pool so that pages stay
+	// consistent; otherwise only the requested prefix is needed.
+	fullPool := rerank || !excl.Empty()
+	topK := offset + limit
+	if topK > recommendationTopK || fullPool {
+		topK = recommendationTopK
+	}
 
 	us, err := u.userSkills.FindByUserID(ctx, userID)
 	if err != nil {
@@ -73,82 +160,252 @@ func (u *JobRecommendation) GetRecommendations(ctx context.Context, userID uuid.
 	if len(us) == 0 {
 		return nil, ErrUserSkillProfileEmpty
 	}
+	userSkills := toEngineUserSkills(us)
+	engine := u.engine()
 
-	jobs, err := u.jobs.ListJobs(ctx, limit, offset)
-	if err != nil {
-		return nil, ErrInternal
+	ranked, ok := u.rankFromStoredMatches(ctx, userID, engine, userSkills, minScore, topK)
+	if !ok {
+		ranked, err = u.rankFromCatalogue(ctx, userID, engine, userSkills, minScore, topK)
+		if err != nil {
+			return nil, err
+		}
 	}
-	if len(jobs) == 0 {
+
+	if offset >= len(ranked) {
 		return nil, ErrNoJobsFound
 	}
 
-	jobIDs := make([]uuid.UUID, 0, len(jobs))
+	detailed := ranked
+	if !fullPool {
+		detailed = paginate(ranked, offset, limit)
+	}
+	ids := make([]uuid.UUID, 0, len(detailed))
+	for _, r := range detailed {
+		ids = append(ids, r.id)
+	}
+	jobs, err := u.jobs.FindByIDs(ctx, ids)
+	if err != nil {
+		return nil, ErrInternal
+	}
+	byID := make(map[uuid.UUID]repository.Job, len(jobs))
 	for _, j := range jobs {
-		if j.ID == uuid.Nil {
+		byID[j.ID] = j
+	}
+
+	items := make([]JobRecommendationItem, 0, len(detailed))
+	for _, r := range detailed {
+		j, ok := byID[r.id]
+		if !ok || excl.Excludes(j.ID, j.Company) {
 			continue
 		}
-		jobIDs = append(jobIDs, j.ID)
+		rank := len(items) + 1
+		if !fullPool {
+			rank += offset
+		}
+		items = append(items, JobRecommendationItem{
+			JobID:            r.id,
+			Title:            j.Title,
+			CompanyName:      j.Company,
+			Location:         j.Location,
+			MatchScore:       r.result.MatchScore,
+			MandatoryMissing: r.result.MandatoryMissing,
+			MissingSkills:    r.result.MissingSkills,
+			Rank:             rank,
+			OriginalRank:     rank,
+		})
 	}
 
-	reqsByJobID, err := u.jobSkills.FindByJobIDs(ctx, jobIDs)
-	if err != nil {
-		return nil, ErrInternal
+	out := items
+	if rerank {
+		out = diversifyRecommendations(items, diversity, maxPerCompany)
+	}
+	if fullPool {
+		out = paginate(out, offset, limit)
+	}
+	if len(out) == 0 {
+		return nil, ErrNoJobsFound
+	}
+	return out, nil
+}
+
+func (u *JobRecommendation) engine() matching.Engine {
+	if u.engines == nil {
+		return matching.NewEngine(matching.DefaultV2Profile())
 	}
+	return u.engines.Engine()
+}
 
-	engineUserSkills := make([]matching.UserSkill, 0, len(us))
-	for _, it := range us {
-		engineUserSkills = append(engineUserSkills, matching.UserSkill{
-			SkillID:          it.SkillID,
-			SkillName:        it.SkillName,
-			ProficiencyLevel: it.ProficiencyLevel,
-			YearsExperience:  it.YearsExperience,
-		})
+// rankFromStoredMatches serves the ranking from job_matches when the user has
+// no pending changes and the rows were produced recently by the active
+// profile. Stored scores pick the candidates, which are rescored to fill in
+// skill details; the rescored results are what gets filtered, ordered and
+// returned, so the response never disagrees with the scores it shows.
+func (u *JobRecommendation) rankFromStoredMatches(ctx context.Context, userID uuid.UUID, engine matching.Engine, userSkills []matching.UserSkillV2, minScore, topK int) ([]rankedJob, bool) {
+	if u.matches == nil {
+		return nil, false
+	}
+	if u.dirty != nil {
+		dirty, err := u.dirty.IsUserDirty(ctx, userID)
+		if err != nil || dirty {
+			return nil, false
+		}
 	}
 
-	out := make([]JobRecommendationItem, 0, len(jobs))
-	for _, j := range jobs {
-		reqs := reqsByJobID[j.ID]
-		engineReqs := make([]matching.JobRequirement, 0, len(reqs))
-		for _, r := range reqs {
-			requiredLevel := r.ImportanceWeight
-			if requiredLevel < 1 {
-				requiredLevel = 1
-			}
-			if requiredLevel > 5 {
-				requiredLevel = 5
+	rows, err := u.matches.ListTopByUser(ctx, userID, topK)
+	if err != nil || len(rows) == 0 {
+		return nil, false
+	}
+	cutoff := time.Now().Add(-u.matchTTL)
+	for _, r := range rows {
+		if r.ScoringProfile != engine.Profile.Name || r.ScoringVersion != engine.Profile.Version || r.MatchedAt.Before(cutoff) {
+			return nil, false
+		}
+	}
+
+	ids := make([]uuid.UUID, 0, len(rows))
+	for _, r := range rows {
+		if int(r.Score) < minScore {
+			break
+		}
+		ids = append(ids, r.JobID)
+	}
+	if len(ids) == 0 {
+		return []rankedJob{}, true
+	}
+
+	scored, err := u.scoreJobs(ctx, userID, engine, userSkills, ids)
+	if err != nil {
+		return nil, false
+	}
+	out := make([]rankedJob, 0, len(ids))
+	for _, id := range ids {
+		if res, ok := scored[id]; ok && res.MatchScore >= minScore {
+			out = append(out, rankedJob{id: id, result: res})
+		}
+	}
+	sortRankedJobs(out)
+	return out, true
+}
+
+// rankFromCatalogue retrieves candidates through the skill index, expanded by
+// skills the relation graph gives credit toward, and scores all of them.
+func (u *JobRecommendation) rankFromCatalogue(ctx context.Context, userID uuid.UUID, engine matching.Engine, userSkills []matching.UserSkillV2, minScore, topK int) ([]rankedJob, error) {
+	seen := make(map[uuid.UUID]struct{}, len(userSkills))
+	skillIDs := make([]uuid.UUID, 0, len(userSkills))
+	addSkill := func(id uuid.UUID) {
+		if id == uuid.Nil {
+			return
+		}
+		if _, ok := seen[id]; ok {
+			return
+		}
+		seen[id] = struct{}{}
+		skillIDs = append(skillIDs, id)
+	}
+	for _, s := range userSkills {
+		addSkill(s.SkillID)
+	}
+	if engine.Profile.RelatedCredit > 0 {
+		for _, s := range userSkills {
+			for _, id := range engine.Graph.CreditedSkills(s.SkillID) {
+				addSkill(id)
 			}
-			engineReqs = append(engineReqs, matching.JobRequirement{
-				SkillID:       r.SkillID,
-				SkillName:     r.SkillName,
-				RequiredLevel: requiredLevel,
-				IsMandatory:   requiredLevel >= 4,
-				RequiredYears: requiredLevel,
-			})
 		}
+	}
+
+	candidates, err := u.jobsQry.ListJobIDsBySkills(ctx, skillIDs, recommendationCandidates)
+	if err != nil {
+		return nil, ErrInternal
+	}
+	if len(candidates) == 0 {
+		return nil, ErrNoJobsFound
+	}
 
-		res := matching.Calculate(engineUserSkills, engineReqs)
+	scored, err := u.scoreJobs(ctx, userID, engine, userSkills, candidates)
+	if err != nil {
+		return nil, err
+	}
+
+	out := make([]rankedJob, 0, len(scored))
+	for id, res := range scored {
 		if res.MatchScore < minScore {
 			continue
 		}
-
-		out = append(out, JobRecommendationItem{
-			JobID:            j.ID,
-			Title:            j.Title,
-			CompanyName:      j.Company,
-			Location:         j.Location,
-			MatchScore:       res.MatchScore,
-			MandatoryMissing: res.MandatoryMissing,
-			MissingSkills:    res.MissingSkills,
-		})
+		out = append(out, rankedJob{id: id, result: res})
 	}
+	sortRankedJobs(out)
+	if len(out) > topK {
+		out = out[:topK]
+	}
+	return out, nil
+}
 
-	sort.SliceStable(out, func(i, j int) bool {
-		return out[i].MatchScore > out[j].MatchScore
+// sortRankedJobs orders by match score, best first, with the job ID as a
+// stable tie-break.
+func sortRankedJobs(out []rankedJob) {
+	sort.Slice(out, func(i, j int) bool {
+		if out[i].result.MatchScore != out[j].result.MatchScore {
+			return out[i].result.MatchScore > out[j].result.MatchScore
+		}
+		return out[i].id.String() < out[j].id.String()
 	})
+}
 
-	if len(out) == 0 {
-		return nil, ErrNoJobsFound
+func (u *JobRecommendation) scoreJobs(ctx context.Context, userID uuid.UUID, engine matching.Engine, userSkills []matching.UserSkillV2, jobIDs []uuid.UUID) (map[uuid.UUID]matching.ResultV2, error) {
+	reqsByJob, err := u.jobSkillsV2.FindByJobIDsV2(ctx, jobIDs)
+	if err != nil {
+		return nil, ErrInternal
+	}
+
+	userRank := userSeniorityRank(ctx, u.seniority, userID)
+	jobRanks := map[uuid.UUID]int{}
+	if userRank > 0 {
+		jobRanks = jobSeniorityRanks(ctx, u.seniority, jobIDs)
 	}
 
+	out := make(map[uuid.UUID]matching.ResultV2, len(jobIDs))
+	for _, id := range jobIDs {
+		reqs := toEngineRequirements(reqsByJob[id])
+		if len(reqs) == 0 {
+			continue
+		}
+		out[id] = engine.ScoreWithSeniority(userSkills, reqs, userRank, jobRanks[id])
+	}
 	return out, nil
 }
+
+func paginate[T any](items []T, offset, limit int) []T {
+	if offset >= len(items) {
+		return nil
+	}
+	end := offset + limit
+	if end > len(items) {
+		end = len(items)
+	}
+	return items[offset:end]
+}
+
+func diversifyRecommendations(items []JobRecommendationItem, diversity float64, maxPerCompany int) []JobRecommendationItem {
+	in := make([]search.DiversityItem, 0, len(items))
+	byID := make(map[uuid.UUID]JobRecommendationItem, len(items))
+	for _, it := range items {
+		in = append(in, search.DiversityItem{
+			ID:          it.JobID,
+			Score:       float64(it.MatchScore),
+			CompanyName: it.CompanyName,
+			Title:       it.Title,
+			Location:    it.Location,
+		})
+		byID[it.JobID] = it
+	}
+
+	out := make([]JobRecommendationItem, 0, len(items))
+	for _, r := range search.Diversify(in, diversity, maxPerCompany) {
+		it := byID[r.ID]
+		it.Rank = r.Rank
+		it.OriginalRank = r.OriginalRank
+		it.RerankReason = r.Reason
+		out = append(out, it)
+	}
+	return out
+}
@@ -0,0 +1,98 @@
+package usecase
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"skill-sync/internal/domain/matching"
+	"skill-sync/internal/repository"
+
+	"github.com/google/uuid"
+)
+
+type recJobRepo struct {
+	mockJobRepo
+	jobs []repository.Job
+}
+
+func (m recJobRepo) FindByIDs(context.Context, []uuid.UUID) ([]repository.Job, error) {
+	return m.jobs, nil
+}
+
+type stubJobSkillV2Repo map[uuid.UUID][]repository.JobSkillRequirementV2
+
+func (m stubJobSkillV2Repo) FindByJobIDV2(_ context.Context, jobID uuid.UUID) ([]repository.JobSkillRequirementV2, error) {
+	return m[jobID], nil
+}
+
+func (m stubJobSkillV2Repo) FindByJobIDsV2(_ context.Context, jobIDs []uuid.UUID) (map[uuid.UUID][]repository.JobSkillRequirementV2, error) {
+	out := make(map[uuid.UUID][]repository.JobSkillRequirementV2, len(jobIDs))
+	for _, id := range jobIDs {
+		if reqs, ok := m[id]; ok {
+			out[id] = reqs
+		}
+	}
+	return out, nil
+}
+
+type stubJobMatchRepo struct {
+	repository.JobMatchRepository
+	rows []repository.JobMatchRow
+}
+
+func (m stubJobMatchRepo) ListTopByUser(context.Context, uuid.UUID, int) ([]repository.JobMatchRow, error) {
+	return m.rows, nil
+}
+
+// Stored scores can lag behind the live score; recommendations served from
+// job_matches must be ordered and filtered by the score they return.
+func TestJobRecommendation_StoredMatchesUseRescoredResult(t *testing.T) {
+	goID, k8sID := uuid.New(), uuid.New()
+	partial, exact, stale := uuid.New(), uuid.New(), uuid.New()
+	mandatory := true
+	req := func(id uuid.UUID, name string) repository.JobSkillRequirementV2 {
+		return repository.JobSkillRequirementV2{SkillID: id, SkillName: name, IsMandatory: &mandatory, ImportanceWeight: 1}
+	}
+
+	profile := matching.DefaultV2Profile()
+	now := time.Now()
+	stored := func(id uuid.UUID, score float64) repository.JobMatchRow {
+		return repository.JobMatchRow{JobID: id, Score: score, ScoringProfile: profile.Name, ScoringVersion: profile.Version, MatchedAt: now}
+	}
+
+	uc := NewJobRecommendationUsecase(
+		recJobRepo{jobs: []repository.Job{{ID: partial, Title: "Platform Engineer"}, {ID: exact, Title: "Go Engineer"}, {ID: stale, Title: "SRE"}}},
+		nil,
+		stubJobSkillV2Repo{
+			partial: {req(goID, "Go"), req(k8sID, "Kubernetes")},
+			exact:   {req(goID, "Go")},
+			stale:   {req(k8sID, "Kubernetes")},
+		},
+		stubUserSkillRepo{skills: []repository.UserSkill{{SkillID: goID, SkillName: "Go", ProficiencyLevel: 5, YearsExperience: 5}}},
+		stubJobMatchRepo{rows: []repository.JobMatchRow{stored(partial, 90), stored(exact, 80), stored(stale, 70)}},
+		nil, nil, nil, nil, 0,
+	)
+
+	items, err := uc.GetRecommendations(context.Background(), uuid.New(), JobRecommendationParams{Limit: 10, MinScore: 20})
+	if err != nil {
+		t.Fatalf("unexpected err: %v", err)
+	}
+	if len(items) == 0 || items[0].JobID != exact {
+		t.Fatalf("expected the fully matched job first, got %+v", items)
+	}
+	for i, it := range items {
+		if it.JobID == stale {
+			t.Fatalf("expected the job now below min_score to be dropped, got %+v", items)
+		}
+		if it.MatchScore < 20 {
+			t.Fatalf("item %d scores %d, below min_score", i, it.MatchScore)
+		}
+		if i > 0 && it.MatchScore > items[i-1].MatchScore {
+			t.Fatalf("items out of score order: %+v", items)
+		}
+		if it.Rank != i+1 {
+			t.Fatalf("item %d has rank %d", i, it.Rank)
+		}
+	}
+}
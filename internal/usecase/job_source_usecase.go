@@ -0,0 +1,67 @@
+package usecase
+
+import (
+	"context"
+	"errors"
+	"log"
+
+	"skill-sync/internal/repository"
+
+	"github.com/google/uuid"
+)
+
+var ErrJobSourceNotFound = errors.New("job source not found")
+
+// maxSourceWeight matches the job_sources.ranking_weight check constraint.
+const maxSourceWeight = 10
+
+type JobSourceUsecase interface {
+	ListSources(ctx context.Context) ([]repository.JobSource, error)
+	UpdateRankingWeight(ctx context.Context, id uuid.UUID, weight float64) (repository.JobSource, error)
+}
+
+type searchCachePurger interface {
+	DeleteByPattern(ctx context.Context, pattern string) error
+}
+
+type JobSources struct {
+	repo   repository.JobSourceRepository
+	cache  searchCachePurger
+	logger *log.Logger
+}
+
+func NewJobSourceUsecase(repo repository.JobSourceRepository, cache searchCachePurger, logger *log.Logger) *JobSources {
+	return &JobSources{repo: repo, cache: cache, logger: logger}
+}
+
+func (u *JobSources) ListSources(ctx context.Context) ([]repository.JobSource, error) {
+	items, err := u.repo.ListSources(ctx)
+	if err != nil {
+		return nil, ErrInternal
+	}
+	return items, nil
+}
+
+// UpdateRankingWeight changes how strongly a source's jobs are favoured in
+// /jobs. Cached searches are dropped since their order depends on it.
+func (u *JobSources) UpdateRankingWeight(ctx context.Context, id uuid.UUID, weight float64) (repository.JobSource, error) {
+	if id == uuid.Nil || weight <= 0 || weight > maxSourceWeight {
+		return repository.JobSource{}, ErrInvalidInput
+	}
+	it, ok, err := u.repo.UpdateRankingWeight(ctx, id, round2(weight))
+	if err != nil {
+		return repository.JobSource{}, ErrInternal
+	}
+	if !ok {
+		return repository.JobSource{}, ErrJobSourceNotFound
+	}
+	if u.cache != nil {
+		if err := u.cache.DeleteByPattern(ctx, "jobs:search:*"); err != nil && u.logger != nil {
+			u.logger.Printf("[Search] Cache purge after source weight change failed: %v", err)
+		}
+	}
+	if u.logger != nil {
+		u.logger.Printf("[Search] Source ranking weight updated source=%s weight=%.2f", it.Name, it.RankingWeight)
+	}
+	return it, nil
+}
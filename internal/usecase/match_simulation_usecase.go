@@ -0,0 +1,169 @@
+package usecase
+
+import (
+	"context"
+	"math"
+	"strings"
+
+	"skill-sync/internal/domain/matching"
+
+	"github.com/google/uuid"
+)
+
+const (
+	SkillChangeAdd        = "add"
+	SkillChangeRemove     = "remove"
+	SkillChangeRaiseLevel = "raise_level"
+	SkillChangeAddYears   = "add_years"
+)
+
+const maxSimulationChanges = 20
+
+// SkillChange is one hypothetical edit to the caller's skill set. Level is the
+// target proficiency for add/raise_level; Years is the absolute years for add
+// and the increment for add_years.
+type SkillChange struct {
+	Op      string
+	SkillID uuid.UUID
+	Level   int
+	Years   int
+}
+
+type MatchDelta struct {
+	MatchScore int
+	Mandatory  float64
+	Optional   float64
+	Experience float64
+	Seniority  float64
+}
+
+type MatchSimulation struct {
+	Current   matching.ResultV2
+	Simulated matching.ResultV2
+	Delta     MatchDelta
+}
+
+func (u *MatchingV2) SimulateMatchV2(ctx context.Context, userID, jobID uuid.UUID, changes []SkillChange) (MatchSimulation, error) {
+	if len(changes) == 0 || len(changes) > maxSimulationChanges {
+		return MatchSimulation{}, ErrInvalidInput
+	}
+
+	userSkills, reqs, err := u.loadMatchInputs(ctx, userID, jobID)
+	if err != nil {
+		return MatchSimulation{}, err
+	}
+
+	simulated, err := u.applySkillChanges(ctx, userSkills, reqs, changes)
+	if err != nil {
+		return MatchSimulation{}, err
+	}
+
+	engine := u.Engine()
+	userRank, jobRank := u.seniorityRanks(ctx, userID, jobID)
+	current := engine.ScoreWithSeniority(userSkills, reqs, userRank, jobRank)
+	next := engine.ScoreWithSeniority(simulated, reqs, userRank, jobRank)
+
+	return MatchSimulation{
+		Current:   current,
+		Simulated: next,
+		Delta: MatchDelta{
+			MatchScore: next.MatchScore - current.MatchScore,
+			Mandatory:  round2(next.Breakdown.Mandatory - current.Breakdown.Mandatory),
+			Optional:   round2(next.Breakdown.Optional - current.Breakdown.Optional),
+			Experience: round2(next.Breakdown.Experience - current.Breakdown.Experience),
+			Seniority:  round2(next.Breakdown.Seniority - current.Breakdown.Seniority),
+		},
+	}, nil
+}
+
+func (u *MatchingV2) applySkillChanges(ctx context.Context, userSkills []matching.UserSkillV2, reqs []matching.JobRequirementV2, changes []SkillChange) ([]matching.UserSkillV2, error) {
+	names := make(map[uuid.UUID]string, len(reqs))
+	for _, r := range reqs {
+		names[r.SkillID] = r.SkillName
+	}
+
+	order := make([]uuid.UUID, 0, len(userSkills))
+	byID := make(map[uuid.UUID]matching.UserSkillV2, len(userSkills))
+	for _, us := range userSkills {
+		if _, ok := byID[us.SkillID]; !ok {
+			order = append(order, us.SkillID)
+		}
+		byID[us.SkillID] = us
+		if _, ok := names[us.SkillID]; !ok {
+			names[us.SkillID] = us.SkillName
+		}
+	}
+
+	for _, ch := range changes {
+		if ch.SkillID == uuid.Nil {
+			return nil, ErrInvalidInput
+		}
+		cur, held := byID[ch.SkillID]
+
+		switch strings.ToLower(strings.TrimSpace(ch.Op)) {
+		case SkillChangeAdd:
+			if !isValidProficiency(ch.Level) {
+				return nil, ErrInvalidProficiencyLevel
+			}
+			if ch.Years < 0 {
+				return nil, ErrInvalidInput
+			}
+			if !held {
+				name, ok := names[ch.SkillID]
+				if !ok {
+					exists, err := u.userSkills.SkillExistsByID(ctx, ch.SkillID)
+					if err != nil {
+						return nil, ErrInternal
+					}
+					if !exists {
+						return nil, ErrSkillNotFound
+					}
+				}
+				cur = matching.UserSkillV2{SkillID: ch.SkillID, SkillName: name}
+				order = append(order, ch.SkillID)
+			}
+			cur.ProficiencyLevel = ch.Level
+			cur.YearsExperience = ch.Years
+		case SkillChangeRemove:
+			if !held {
+				return nil, ErrSkillNotFound
+			}
+			delete(byID, ch.SkillID)
+			continue
+		case SkillChangeRaiseLevel:
+			if !held {
+				return nil, ErrSkillNotFound
+			}
+			if !isValidProficiency(ch.Level) {
+				return nil, ErrInvalidProficiencyLevel
+			}
+			if ch.Level > cur.ProficiencyLevel {
+				cur.ProficiencyLevel = ch.Level
+			}
+		case SkillChangeAddYears:
+			if !held {
+				return nil, ErrSkillNotFound
+			}
+			if ch.Years <= 0 {
+				return nil, ErrInvalidInput
+			}
+			cur.YearsExperience += ch.Years
+		default:
+			return nil, ErrInvalidInput
+		}
+		byID[ch.SkillID] = cur
+	}
+
+	out := make([]matching.UserSkillV2, 0, len(byID))
+	for _, id := range order {
+		if us, ok := byID[id]; ok {
+			out = append(out, us)
+			delete(byID, id)
+		}
+	}
+	return out, nil
+}
+
+func round2(v float64) float64 {
+	return math.Round(v*100) / 100
+}
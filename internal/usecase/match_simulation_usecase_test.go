@@ -0,0 +1,157 @@
+package usecase
+
+import (
+	"context"
+	"errors"
+	"reflect"
+	"testing"
+
+	"skill-sync/internal/domain/matching"
+	"skill-sync/internal/repository"
+
+	"github.com/google/uuid"
+)
+
+type stubUserSkillRepo struct {
+	repository.UserSkillRepository
+	skills  []repository.UserSkill
+	catalog map[uuid.UUID]bool
+}
+
+func (m stubUserSkillRepo) FindByUserID(context.Context, uuid.UUID) ([]repository.UserSkill, error) {
+	return m.skills, nil
+}
+
+func (m stubUserSkillRepo) SkillExistsByID(_ context.Context, id uuid.UUID) (bool, error) {
+	return m.catalog[id], nil
+}
+
+func TestMatchingV2_ApplySkillChanges(t *testing.T) {
+	goID, sqlID, dockerID, k8sID, unknownID := uuid.New(), uuid.New(), uuid.New(), uuid.New(), uuid.New()
+	held := []matching.UserSkillV2{
+		{SkillID: goID, SkillName: "Go", ProficiencyLevel: 3, YearsExperience: 2},
+		{SkillID: sqlID, SkillName: "SQL", ProficiencyLevel: 2, YearsExperience: 1},
+	}
+	reqs := []matching.JobRequirementV2{{SkillID: dockerID, SkillName: "Docker"}}
+	uc := &MatchingV2{userSkills: stubUserSkillRepo{catalog: map[uuid.UUID]bool{k8sID: true}}}
+
+	cases := []struct {
+		name    string
+		skills  []matching.UserSkillV2
+		changes []SkillChange
+		want    []matching.UserSkillV2
+		err     error
+	}{
+		{
+			name:    "add a required skill",
+			changes: []SkillChange{{Op: SkillChangeAdd, SkillID: dockerID, Level: 2, Years: 1}},
+			want:    append(append([]matching.UserSkillV2{}, held...), matching.UserSkillV2{SkillID: dockerID, SkillName: "Docker", ProficiencyLevel: 2, YearsExperience: 1}),
+		},
+		{
+			name:    "add a catalogue skill the job does not list",
+			changes: []SkillChange{{Op: SkillChangeAdd, SkillID: k8sID, Level: 1}},
+			want:    append(append([]matching.UserSkillV2{}, held...), matching.UserSkillV2{SkillID: k8sID, ProficiencyLevel: 1}),
+		},
+		{
+			name:    "add a held skill replaces it in place",
+			changes: []SkillChange{{Op: SkillChangeAdd, SkillID: goID, Level: 5, Years: 4}},
+			want: []matching.UserSkillV2{
+				{SkillID: goID, SkillName: "Go", ProficiencyLevel: 5, YearsExperience: 4},
+				held[1],
+			},
+		},
+		{
+			name:   "duplicate held skills collapse to the last",
+			skills: []matching.UserSkillV2{held[0], held[1], {SkillID: goID, SkillName: "Go", ProficiencyLevel: 4}},
+			changes: []SkillChange{
+				{Op: SkillChangeAddYears, SkillID: goID, Years: 1},
+			},
+			want: []matching.UserSkillV2{
+				{SkillID: goID, SkillName: "Go", ProficiencyLevel: 4, YearsExperience: 1},
+				held[1],
+			},
+		},
+		{
+			name: "duplicate changes apply in order",
+			changes: []SkillChange{
+				{Op: SkillChangeAdd, SkillID: dockerID, Level: 2},
+				{Op: SkillChangeAdd, SkillID: dockerID, Level: 3, Years: 2},
+			},
+			want: append(append([]matching.UserSkillV2{}, held...), matching.UserSkillV2{SkillID: dockerID, SkillName: "Docker", ProficiencyLevel: 3, YearsExperience: 2}),
+		},
+		{
+			name: "remove then add again keeps one entry",
+			changes: []SkillChange{
+				{Op: SkillChangeRemove, SkillID: goID},
+				{Op: SkillChangeAdd, SkillID: goID, Level: 1},
+			},
+			want: []matching.UserSkillV2{{SkillID: goID, SkillName: "Go", ProficiencyLevel: 1}, held[1]},
+		},
+		{
+			name:    "remove a held skill",
+			changes: []SkillChange{{Op: SkillChangeRemove, SkillID: sqlID}},
+			want:    held[:1],
+		},
+		{
+			name:    "raise_level never lowers",
+			changes: []SkillChange{{Op: SkillChangeRaiseLevel, SkillID: goID, Level: 1}},
+			want:    held,
+		},
+		{
+			name:    "remove an unheld skill",
+			changes: []SkillChange{{Op: SkillChangeRemove, SkillID: dockerID}},
+			err:     ErrSkillNotFound,
+		},
+		{
+			name:    "raise an unheld skill",
+			changes: []SkillChange{{Op: SkillChangeRaiseLevel, SkillID: dockerID, Level: 3}},
+			err:     ErrSkillNotFound,
+		},
+		{
+			name:    "add an unknown skill",
+			changes: []SkillChange{{Op: SkillChangeAdd, SkillID: unknownID, Level: 2}},
+			err:     ErrSkillNotFound,
+		},
+		{
+			name:    "add with an invalid level",
+			changes: []SkillChange{{Op: SkillChangeAdd, SkillID: dockerID, Level: 6}},
+			err:     ErrInvalidProficiencyLevel,
+		},
+		{
+			name:    "add_years needs a positive increment",
+			changes: []SkillChange{{Op: SkillChangeAddYears, SkillID: goID}},
+			err:     ErrInvalidInput,
+		},
+		{
+			name:    "unknown op",
+			changes: []SkillChange{{Op: "learn", SkillID: goID}},
+			err:     ErrInvalidInput,
+		},
+		{
+			name:    "missing skill id",
+			changes: []SkillChange{{Op: SkillChangeRemove}},
+			err:     ErrInvalidInput,
+		},
+	}
+	for _, tc := range cases {
+		t.Run(tc.name, func(t *testing.T) {
+			skills := tc.skills
+			if skills == nil {
+				skills = held
+			}
+			got, err := uc.applySkillChanges(context.Background(), skills, reqs, tc.changes)
+			if tc.err != nil {
+				if !errors.Is(err, tc.err) {
+					t.Fatalf("err = %v, want %v", err, tc.err)
+				}
+				return
+			}
+			if err != nil {
+				t.Fatalf("unexpected err: %v", err)
+			}
+			if !reflect.DeepEqual(got, tc.want) {
+				t.Fatalf("got %+v, want %+v", got, tc.want)
+			}
+		})
+	}
+}
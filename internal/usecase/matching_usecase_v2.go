@@ -3,6 +3,7 @@ package usecase
 import (
 	"context"
 
+	"skill-sync/internal/domain/job"
 	"skill-sync/internal/domain/matching"
 	"skill-sync/internal/repository"
 
@@ -11,60 +12,138 @@ import (
 
 type MatchingUsecaseV2 interface {
 	CalculateMatchV2(ctx context.Context, userID, jobID uuid.UUID) (matching.ResultV2, error)
+	SimulateMatchV2(ctx context.Context, userID, jobID uuid.UUID, changes []SkillChange) (MatchSimulation, error)
+}
+
+type MatchEngineProvider interface {
+	Engine() matching.Engine
+}
+
+type SkillGraphProvider interface {
+	Graph() *matching.SkillGraph
 }
 
 type MatchingV2 struct {
-	jobs       repository.JobRepository
+	jobs        repository.JobRepository
 	jobSkillsV2 repository.JobSkillV2Repository
-	userSkills repository.UserSkillRepository
+	userSkills  repository.UserSkillRepository
+	profiles    *matching.ProfileRegistry
+	graphs      SkillGraphProvider
+	seniority   repository.SeniorityRepository
+}
+
+func NewMatchingUsecaseV2(jobs repository.JobRepository, jobSkillsV2 repository.JobSkillV2Repository, userSkills repository.UserSkillRepository, profiles *matching.ProfileRegistry, graphs SkillGraphProvider, seniority repository.SeniorityRepository) *MatchingV2 {
+	return &MatchingV2{jobs: jobs, jobSkillsV2: jobSkillsV2, userSkills: userSkills, profiles: profiles, graphs: graphs, seniority: seniority}
 }
 
-func NewMatchingUsecaseV2(jobs repository.JobRepository, jobSkillsV2 repository.JobSkillV2Repository, userSkills repository.UserSkillRepository) *MatchingV2 {
-	return &MatchingV2{jobs: jobs, jobSkillsV2: jobSkillsV2, userSkills: userSkills}
+func (u *MatchingV2) Engine() matching.Engine {
+	e := matching.NewEngine(u.profiles.Active())
+	if u.graphs != nil {
+		e.Graph = u.graphs.Graph()
+	}
+	return e
 }
 
 func (u *MatchingV2) CalculateMatchV2(ctx context.Context, userID, jobID uuid.UUID) (matching.ResultV2, error) {
+	engineUserSkills, engineReqs, err := u.loadMatchInputs(ctx, userID, jobID)
+	if err != nil {
+		return matching.ResultV2{}, err
+	}
+	if len(engineUserSkills) == 0 {
+		return matching.ResultV2{}, ErrUserSkillProfileEmpty
+	}
+
+	userRank, jobRank := u.seniorityRanks(ctx, userID, jobID)
+	res := u.Engine().ScoreWithSeniority(engineUserSkills, engineReqs, userRank, jobRank)
+	return res, nil
+}
+
+// seniorityRanks resolves the user's experience level and the job's seniority.
+// Lookups are best effort: an unknown side disables the seniority penalty.
+func (u *MatchingV2) seniorityRanks(ctx context.Context, userID, jobID uuid.UUID) (int, int) {
+	userRank := userSeniorityRank(ctx, u.seniority, userID)
+	if userRank == 0 {
+		return 0, 0
+	}
+	level, err := u.seniority.JobSeniority(ctx, jobID)
+	if err != nil {
+		return 0, 0
+	}
+	return userRank, job.SeniorityRank(level)
+}
+
+func userSeniorityRank(ctx context.Context, repo repository.SeniorityRepository, userID uuid.UUID) int {
+	if repo == nil {
+		return 0
+	}
+	raw, err := repo.UserExperienceLevel(ctx, userID)
+	if err != nil {
+		return 0
+	}
+	return job.SeniorityRank(job.ParseSeniority(raw))
+}
+
+func jobSeniorityRanks(ctx context.Context, repo repository.SeniorityRepository, jobIDs []uuid.UUID) map[uuid.UUID]int {
+	out := make(map[uuid.UUID]int)
+	if repo == nil {
+		return out
+	}
+	levels, err := repo.ListJobSeniorities(ctx, jobIDs)
+	if err != nil {
+		return out
+	}
+	for id, level := range levels {
+		out[id] = job.SeniorityRank(level)
+	}
+	return out
+}
+
+func (u *MatchingV2) loadMatchInputs(ctx context.Context, userID, jobID uuid.UUID) ([]matching.UserSkillV2, []matching.JobRequirementV2, error) {
 	if userID == uuid.Nil {
-		return matching.ResultV2{}, ErrUnauthorized
+		return nil, nil, ErrUnauthorized
 	}
 	if jobID == uuid.Nil {
-		return matching.ResultV2{}, ErrJobNotFound
+		return nil, nil, ErrJobNotFound
 	}
 
 	exists, err := u.jobs.ExistsByID(ctx, jobID)
 	if err != nil {
-		return matching.ResultV2{}, ErrInternal
+		return nil, nil, ErrInternal
 	}
 	if !exists {
-		return matching.ResultV2{}, ErrJobNotFound
+		return nil, nil, ErrJobNotFound
 	}
 
 	us, err := u.userSkills.FindByUserID(ctx, userID)
 	if err != nil {
-		return matching.ResultV2{}, ErrInternal
-	}
-	if len(us) == 0 {
-		return matching.ResultV2{}, ErrUserSkillProfileEmpty
+		return nil, nil, ErrInternal
 	}
 
 	reqs, err := u.jobSkillsV2.FindByJobIDV2(ctx, jobID)
 	if err != nil {
-		return matching.ResultV2{}, ErrInternal
+		return nil, nil, ErrInternal
 	}
 
-	engineUserSkills := make([]matching.UserSkillV2, 0, len(us))
+	return toEngineUserSkills(us), toEngineRequirements(reqs), nil
+}
+
+func toEngineUserSkills(us []repository.UserSkill) []matching.UserSkillV2 {
+	out := make([]matching.UserSkillV2, 0, len(us))
 	for _, it := range us {
-		engineUserSkills = append(engineUserSkills, matching.UserSkillV2{
+		out = append(out, matching.UserSkillV2{
 			SkillID:          it.SkillID,
 			SkillName:        it.SkillName,
 			ProficiencyLevel: it.ProficiencyLevel,
 			YearsExperience:  it.YearsExperience,
 		})
 	}
+	return out
+}
 
-	engineReqs := make([]matching.JobRequirementV2, 0, len(reqs))
+func toEngineRequirements(reqs []repository.JobSkillRequirementV2) []matching.JobRequirementV2 {
+	out := make([]matching.JobRequirementV2, 0, len(reqs))
 	for _, r := range reqs {
-		engineReqs = append(engineReqs, matching.JobRequirementV2{
+		out = append(out, matching.JobRequirementV2{
 			SkillID:          r.SkillID,
 			SkillName:        r.SkillName,
 			RequiredLevel:    r.RequiredLevel,
@@ -73,7 +152,5 @@ func (u *MatchingV2) CalculateMatchV2(ctx context.Context, userID, jobID uuid.UU
 			ImportanceWeight: r.ImportanceWeight,
 		})
 	}
-
-	res := matching.CalculateV2(engineUserSkills, engineReqs)
-	return res, nil
+	return out
 }
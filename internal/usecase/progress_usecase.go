@@ -0,0 +1,127 @@
+package usecase
+
+import (
+	"context"
+	"time"
+
+	"skill-sync/internal/repository"
+
+	"github.com/google/uuid"
+)
+
+const (
+	defaultProgressDays = 90
+	maxProgressDays     = 365
+)
+
+type ProgressPoint struct {
+	RecordedAt     time.Time
+	TopAverage     float64
+	MatchedJobs    int
+	Change         float64
+	ScoringVersion int
+	// ScoringChanged marks jumps caused by a new scoring profile version
+	// rather than by the user.
+	ScoringChanged bool
+	SkillChanges   []repository.SkillEvent
+}
+
+type UserProgress struct {
+	TopN    int
+	Days    int
+	Current float64
+	// Change is the movement across the window.
+	Change float64
+	Points []ProgressPoint
+	// PendingSkillChanges are edits made after the latest snapshot; their
+	// effect shows up after the next matching run.
+	PendingSkillChanges []repository.SkillEvent
+}
+
+type ProgressUsecase interface {
+	GetProgress(ctx context.Context, userID uuid.UUID, days int) (UserProgress, error)
+	GetJobMatchHistory(ctx context.Context, userID, jobID uuid.UUID) ([]repository.MatchHistoryEntry, error)
+}
+
+type Progress struct {
+	history repository.MatchHistoryRepository
+}
+
+func NewProgressUsecase(history repository.MatchHistoryRepository) *Progress {
+	return &Progress{history: history}
+}
+
+func (u *Progress) GetProgress(ctx context.Context, userID uuid.UUID, days int) (UserProgress, error) {
+	if userID == uuid.Nil {
+		return UserProgress{}, ErrUnauthorized
+	}
+	if days <= 0 {
+		days = defaultProgressDays
+	}
+	if days > maxProgressDays {
+		days = maxProgressDays
+	}
+	since := time.Now().AddDate(0, 0, -days)
+
+	snaps, err := u.history.ListSnapshots(ctx, userID, since, 0)
+	if err != nil {
+		return UserProgress{}, ErrInternal
+	}
+	eventsSince := since
+	if len(snaps) > 0 && snaps[0].RecordedAt.Before(eventsSince) {
+		eventsSince = snaps[0].RecordedAt
+	}
+	events, err := u.history.ListSkillEvents(ctx, userID, eventsSince)
+	if err != nil {
+		return UserProgress{}, ErrInternal
+	}
+
+	out := UserProgress{Days: days, Points: make([]ProgressPoint, 0, len(snaps))}
+	next := 0
+	for i, s := range snaps {
+		p := ProgressPoint{
+			RecordedAt:     s.RecordedAt,
+			TopAverage:     s.TopAverage,
+			MatchedJobs:    s.MatchedJobs,
+			ScoringVersion: s.ScoringVersion,
+			SkillChanges:   []repository.SkillEvent{},
+		}
+		for next < len(events) && !events[next].CreatedAt.After(s.RecordedAt) {
+			p.SkillChanges = append(p.SkillChanges, events[next])
+			next++
+		}
+		if i > 0 {
+			prev := snaps[i-1]
+			p.Change = round2(s.TopAverage - prev.TopAverage)
+			p.ScoringChanged = s.ScoringVersion != prev.ScoringVersion
+		}
+		// The snapshot before the window only anchors the first change.
+		if s.RecordedAt.Before(since) {
+			continue
+		}
+		out.Points = append(out.Points, p)
+	}
+	out.PendingSkillChanges = events[next:]
+
+	if len(snaps) > 0 {
+		last := snaps[len(snaps)-1]
+		out.TopN = last.TopN
+		out.Current = last.TopAverage
+		out.Change = round2(last.TopAverage - snaps[0].TopAverage)
+	}
+	return out, nil
+}
+
+func (u *Progress) GetJobMatchHistory(ctx context.Context, userID, jobID uuid.UUID) ([]repository.MatchHistoryEntry, error) {
+	if userID == uuid.Nil {
+		return nil, ErrUnauthorized
+	}
+	if jobID == uuid.Nil {
+		return nil, ErrInvalidInput
+	}
+	items, err := u.history.ListPairHistory(ctx, userID, jobID, 0)
+	if err != nil {
+		return nil, ErrInternal
+	}
+	return items, nil
+}
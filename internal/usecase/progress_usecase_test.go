@@ -0,0 +1,93 @@
+package usecase
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"skill-sync/internal/repository"
+
+	"github.com/google/uuid"
+)
+
+type stubMatchHistoryRepo struct {
+	repository.MatchHistoryRepository
+	snaps       []repository.MatchSnapshot
+	events      []repository.SkillEvent
+	eventsSince time.Time
+}
+
+func (m *stubMatchHistoryRepo) ListSnapshots(context.Context, uuid.UUID, time.Time, int) ([]repository.MatchSnapshot, error) {
+	return m.snaps, nil
+}
+
+func (m *stubMatchHistoryRepo) ListSkillEvents(_ context.Context, _ uuid.UUID, since time.Time) ([]repository.SkillEvent, error) {
+	m.eventsSince = since
+	return m.events, nil
+}
+
+func TestProgress_GetProgress(t *testing.T) {
+	now := time.Now()
+	day := func(n int) time.Time { return now.AddDate(0, 0, n) }
+	goID, sqlID, k8sID := uuid.New(), uuid.New(), uuid.New()
+
+	repo := &stubMatchHistoryRepo{
+		snaps: []repository.MatchSnapshot{
+			// Before the 30 day window: only anchors the first change.
+			{TopN: 10, TopAverage: 50, MatchedJobs: 40, ScoringVersion: 2, RecordedAt: day(-40)},
+			{TopN: 10, TopAverage: 55.5, MatchedJobs: 42, ScoringVersion: 2, RecordedAt: day(-20)},
+			{TopN: 10, TopAverage: 61.25, MatchedJobs: 45, ScoringVersion: 3, RecordedAt: day(-5)},
+		},
+		events: []repository.SkillEvent{
+			{SkillID: goID, Action: "updated", CreatedAt: day(-25)},
+			{SkillID: sqlID, Action: "added", CreatedAt: day(-20)},
+			{SkillID: k8sID, Action: "added", CreatedAt: day(-1)},
+		},
+	}
+	uc := NewProgressUsecase(repo)
+
+	got, err := uc.GetProgress(context.Background(), uuid.New(), 30)
+	if err != nil {
+		t.Fatalf("unexpected err: %v", err)
+	}
+	if !repo.eventsSince.Equal(day(-40)) {
+		t.Fatalf("expected skill events from the anchor snapshot, got %v", repo.eventsSince)
+	}
+	if got.TopN != 10 || got.Current != 61.25 || got.Change != 11.25 || got.Days != 30 {
+		t.Fatalf("unexpected summary %+v", got)
+	}
+	if len(got.Points) != 2 {
+		t.Fatalf("expected the anchor left out, got %d points", len(got.Points))
+	}
+
+	first, second := got.Points[0], got.Points[1]
+	if first.Change != 5.5 || first.ScoringChanged {
+		t.Fatalf("unexpected first point %+v", first)
+	}
+	if len(first.SkillChanges) != 2 || first.SkillChanges[0].SkillID != goID || first.SkillChanges[1].SkillID != sqlID {
+		t.Fatalf("expected edits up to and including the snapshot time, got %+v", first.SkillChanges)
+	}
+	if second.Change != 5.75 || !second.ScoringChanged || len(second.SkillChanges) != 0 {
+		t.Fatalf("unexpected second point %+v", second)
+	}
+	if len(got.PendingSkillChanges) != 1 || got.PendingSkillChanges[0].SkillID != k8sID {
+		t.Fatalf("expected the edit after the last snapshot to be pending, got %+v", got.PendingSkillChanges)
+	}
+}
+
+func TestProgress_GetProgress_NoSnapshots(t *testing.T) {
+	repo := &stubMatchHistoryRepo{events: []repository.SkillEvent{{SkillID: uuid.New(), Action: "added", CreatedAt: time.Now()}}}
+	uc := NewProgressUsecase(repo)
+
+	got, err := uc.GetProgress(context.Background(), uuid.New(), 0)
+	if err != nil {
+		t.Fatalf("unexpected err: %v", err)
+	}
+	if got.Days != defaultProgressDays || len(got.Points) != 0 || len(got.PendingSkillChanges) != 1 {
+		t.Fatalf("unexpected progress %+v", got)
+	}
+
+	if _, err := uc.GetProgress(context.Background(), uuid.Nil, 30); err != ErrUnauthorized {
+		t.Fatalf("err = %v, want ErrUnauthorized", err)
+	}
+}
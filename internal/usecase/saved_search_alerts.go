@@ -0,0 +1,220 @@
+package usecase
+
+import (
+	"context"
+	"log"
+	"sync"
+	"time"
+
+	"skill-sync/internal/repository"
+	"skill-sync/internal/search"
+	"skill-sync/internal/ws"
+
+	"github.com/google/uuid"
+)
+
+const (
+	alertJobBatchSize = 500
+	// alertSettleDelay leaves just-inserted jobs for the next run, see
+	// SavedSearchRepository.ListNewJobs.
+	alertSettleDelay = 10 * time.Second
+	// alertPageSize matches the listing query's own limit.
+	alertPageSize = 50
+)
+
+type savedSearchNotifier interface {
+	NotifySavedSearchMatches(userID, searchID uuid.UUID, name string, jobs []ws.SavedSearchJob)
+}
+
+// SavedSearchAlerts re-runs saved searches against jobs created since the
+// previous run, stores the matches and pushes them to users who asked for
+// instant alerts.
+type SavedSearchAlerts struct {
+	searches   repository.SavedSearchRepository
+	jobs       repository.JobRepository
+	exclusions ExclusionProvider
+	notifier   savedSearchNotifier
+	logger     *log.Logger
+
+	mu sync.Mutex
+}
+
+func NewSavedSearchAlerts(searches repository.SavedSearchRepository, jobs repository.JobRepository, exclusions ExclusionProvider, notifier savedSearchNotifier, logger *log.Logger) *SavedSearchAlerts {
+	return &SavedSearchAlerts{searches: searches, jobs: jobs, exclusions: exclusions, notifier: notifier, logger: logger}
+}
+
+// Run polls for new jobs until ctx is cancelled. It catches jobs written by
+// processes that do not call the scrape-completed webhook.
+func (u *SavedSearchAlerts) Run(ctx context.Context, interval time.Duration) {
+	if u == nil || interval <= 0 {
+		return
+	}
+	t := time.NewTicker(interval)
+	defer t.Stop()
+	for {
+		select {
+		case <-ctx.Done():
+			return
+		case <-t.C:
+			if _, err := u.ProcessNewJobs(ctx); err != nil && u.logger != nil {
+				u.logger.Printf("[SavedSearch] Alert run failed | error=%v", err)
+			}
+		}
+	}
+}
+
+// ProcessNewJobs returns the number of new matches stored. The first run only
+// records where the catalogue currently ends, so existing jobs never alert.
+func (u *SavedSearchAlerts) ProcessNewJobs(ctx context.Context) (int, error) {
+	if u == nil || u.searches == nil || u.jobs == nil {
+		return 0, nil
+	}
+	u.mu.Lock()
+	defer u.mu.Unlock()
+
+	cursor, ok, err := u.searches.AlertCursor(ctx)
+	if err != nil {
+		return 0, err
+	}
+	if !ok {
+		now, err := u.searches.Now(ctx)
+		if err != nil {
+			return 0, err
+		}
+		return 0, u.searches.SaveAlertCursor(ctx, repository.NewJobRef{CreatedAt: now.Add(-alertSettleDelay), ID: uuid.Nil})
+	}
+
+	var searches []repository.SavedSearch
+	total := 0
+	for {
+		batch, err := u.searches.ListNewJobs(ctx, cursor, alertSettleDelay, alertJobBatchSize)
+		if err != nil {
+			return total, err
+		}
+		if len(batch) == 0 {
+			return total, nil
+		}
+
+		if searches == nil {
+			searches, err = u.searches.ListAll(ctx)
+			if err != nil {
+				return total, err
+			}
+		}
+		ids := make([]uuid.UUID, 0, len(batch))
+		for _, j := range batch {
+			ids = append(ids, j.ID)
+		}
+		total += u.matchBatch(ctx, searches, ids)
+
+		cursor = batch[len(batch)-1]
+		if err := u.searches.SaveAlertCursor(ctx, cursor); err != nil {
+			return total, err
+		}
+		if len(batch) < alertJobBatchSize {
+			return total, nil
+		}
+	}
+}
+
+// matchBatch is best effort per search: one failing search is logged and
+// does not hold back the cursor for everyone else.
+func (u *SavedSearchAlerts) matchBatch(ctx context.Context, searches []repository.SavedSearch, jobIDs []uuid.UUID) int {
+	exclusions := map[uuid.UUID]repository.UserExclusions{}
+	total := 0
+	for _, s := range searches {
+		excl, ok := exclusions[s.UserID]
+		if !ok && u.exclusions != nil {
+			loaded, err := u.exclusions.ListExclusions(ctx, s.UserID)
+			if err != nil {
+				u.logf("[SavedSearch] Exclusions failed | user=%s error=%v", s.UserID, err)
+				continue
+			}
+			excl = loaded
+			exclusions[s.UserID] = excl
+		}
+
+		rows, err := u.runSearch(ctx, s, jobIDs, excl)
+		if err != nil {
+			u.logf("[SavedSearch] Search failed | id=%s error=%v", s.ID, err)
+			continue
+		}
+		if len(rows) == 0 {
+			continue
+		}
+
+		matched := make([]uuid.UUID, 0, len(rows))
+		for _, r := range rows {
+			matched = append(matched, r.ID)
+		}
+		inserted, err := u.searches.InsertMatches(ctx, s.ID, matched)
+		if err != nil {
+			u.logf("[SavedSearch] Store matches failed | id=%s error=%v", s.ID, err)
+			continue
+		}
+		total += len(inserted)
+
+		if s.Notify != NotifyInstant || u.notifier == nil || len(inserted) == 0 {
+			continue
+		}
+		fresh := make(map[uuid.UUID]struct{}, len(inserted))
+		for _, id := range inserted {
+			fresh[id] = struct{}{}
+		}
+		jobs := make([]ws.SavedSearchJob, 0, len(inserted))
+		for _, r := range rows {
+			if _, ok := fresh[r.ID]; !ok {
+				continue
+			}
+			jobs = append(jobs, ws.SavedSearchJob{
+				JobID:       r.ID.String(),
+				Title:       r.Title,
+				CompanyName: r.Company,
+				Location:    r.Location,
+			})
+		}
+		u.notifier.NotifySavedSearchMatches(s.UserID, s.ID, s.Name, jobs)
+	}
+	return total
+}
+
+// runSearch applies the saved /jobs query to the given jobs only.
+func (u *SavedSearchAlerts) runSearch(ctx context.Context, s repository.SavedSearch, jobIDs []uuid.UUID, excl repository.UserExclusions) ([]repository.JobListRow, error) {
+	qctx := search.ProcessQuery(s.Title)
+	location := s.Location
+	if location == "" {
+		location = qctx.Location
+	}
+	f := repository.JobListFilter{
+		Title:              qctx.Query,
+		TitleVariants:      qctx.Variants,
+		TextQuery:          qctx.TextQuery(),
+		CompanyName:        s.CompanyName,
+		Location:           location,
+		Skills:             s.Skills,
+		Seniority:          s.Seniority,
+		JobIDs:             jobIDs,
+		ExcludeJobIDs:      excl.JobIDs,
+		ExcludeCompanyKeys: excl.CompanyKeys,
+		Limit:              alertPageSize,
+	}
+
+	out := make([]repository.JobListRow, 0)
+	for {
+		rows, err := u.jobs.ListJobsForListing(ctx, f)
+		if err != nil {
+			return nil, err
+		}
+		out = append(out, rows...)
+		if len(rows) < alertPageSize {
+			return out, nil
+		}
+		f.Offset += alertPageSize
+	}
+}
+
+func (u *SavedSearchAlerts) logf(format string, args ...any) {
+	if u.logger != nil {
+		u.logger.Printf(format, args...)
+	}
+}
@@ -0,0 +1,205 @@
+package usecase
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"skill-sync/internal/repository"
+	"skill-sync/internal/ws"
+
+	"github.com/google/uuid"
+)
+
+type fakeSavedSearchRepo struct {
+	repository.SavedSearchRepository
+	now      time.Time
+	cursor   *repository.NewJobRef
+	saved    []repository.NewJobRef
+	newJobs  []repository.NewJobRef
+	searches []repository.SavedSearch
+	stored   map[uuid.UUID]map[uuid.UUID]bool
+	pages    int
+}
+
+func (m *fakeSavedSearchRepo) Now(context.Context) (time.Time, error) { return m.now, nil }
+
+func (m *fakeSavedSearchRepo) AlertCursor(context.Context) (repository.NewJobRef, bool, error) {
+	if m.cursor == nil {
+		return repository.NewJobRef{}, false, nil
+	}
+	return *m.cursor, true, nil
+}
+
+func (m *fakeSavedSearchRepo) SaveAlertCursor(_ context.Context, c repository.NewJobRef) error {
+	m.saved = append(m.saved, c)
+	m.cursor = &c
+	return nil
+}
+
+func (m *fakeSavedSearchRepo) ListNewJobs(_ context.Context, after repository.NewJobRef, _ time.Duration, limit int) ([]repository.NewJobRef, error) {
+	m.pages++
+	out := make([]repository.NewJobRef, 0)
+	for _, j := range m.newJobs {
+		if j.CreatedAt.After(after.CreatedAt) && len(out) < limit {
+			out = append(out, j)
+		}
+	}
+	return out, nil
+}
+
+func (m *fakeSavedSearchRepo) ListAll(context.Context) ([]repository.SavedSearch, error) {
+	return m.searches, nil
+}
+
+func (m *fakeSavedSearchRepo) InsertMatches(_ context.Context, searchID uuid.UUID, jobIDs []uuid.UUID) ([]uuid.UUID, error) {
+	if m.stored[searchID] == nil {
+		m.stored[searchID] = map[uuid.UUID]bool{}
+	}
+	inserted := make([]uuid.UUID, 0, len(jobIDs))
+	for _, id := range jobIDs {
+		if !m.stored[searchID][id] {
+			m.stored[searchID][id] = true
+			inserted = append(inserted, id)
+		}
+	}
+	return inserted, nil
+}
+
+// alertJobRepo answers the listing query like the database would: the given
+// job IDs minus exclusions, one page at a time.
+type alertJobRepo struct {
+	mockJobRepo
+	filters *[]repository.JobListFilter
+}
+
+func (m alertJobRepo) ListJobsForListing(_ context.Context, f repository.JobListFilter) ([]repository.JobListRow, error) {
+	*m.filters = append(*m.filters, f)
+	excluded := map[uuid.UUID]bool{}
+	for _, id := range f.ExcludeJobIDs {
+		excluded[id] = true
+	}
+	rows := make([]repository.JobListRow, 0)
+	for _, id := range f.JobIDs {
+		if !excluded[id] {
+			rows = append(rows, repository.JobListRow{ID: id, Title: "Backend Engineer"})
+		}
+	}
+	if f.Offset >= len(rows) {
+		return nil, nil
+	}
+	rows = rows[f.Offset:]
+	if len(rows) > f.Limit {
+		rows = rows[:f.Limit]
+	}
+	return rows, nil
+}
+
+type stubExclusions map[uuid.UUID]repository.UserExclusions
+
+func (m stubExclusions) ListExclusions(_ context.Context, userID uuid.UUID) (repository.UserExclusions, error) {
+	return m[userID], nil
+}
+
+type notifyCall struct {
+	searchID uuid.UUID
+	jobs     []ws.SavedSearchJob
+}
+
+type fakeNotifier struct{ calls []notifyCall }
+
+func (m *fakeNotifier) NotifySavedSearchMatches(_, searchID uuid.UUID, _ string, jobs []ws.SavedSearchJob) {
+	m.calls = append(m.calls, notifyCall{searchID: searchID, jobs: jobs})
+}
+
+func newJobRefs(n int, start time.Time) []repository.NewJobRef {
+	out := make([]repository.NewJobRef, 0, n)
+	for i := 0; i < n; i++ {
+		out = append(out, repository.NewJobRef{ID: uuid.New(), CreatedAt: start.Add(time.Duration(i+1) * time.Second)})
+	}
+	return out
+}
+
+func TestSavedSearchAlerts_FirstRunSeedsCursorFromDatabaseClock(t *testing.T) {
+	dbNow := time.Date(2026, 3, 4, 5, 6, 7, 0, time.UTC)
+	repo := &fakeSavedSearchRepo{now: dbNow, stored: map[uuid.UUID]map[uuid.UUID]bool{}}
+	var filters []repository.JobListFilter
+	uc := NewSavedSearchAlerts(repo, alertJobRepo{filters: &filters}, nil, nil, nil)
+
+	n, err := uc.ProcessNewJobs(context.Background())
+	if err != nil {
+		t.Fatalf("unexpected err: %v", err)
+	}
+	if n != 0 || repo.pages != 0 {
+		t.Fatalf("expected the first run to only seed the cursor, got %d matches, %d pages", n, repo.pages)
+	}
+	if len(repo.saved) != 1 || !repo.saved[0].CreatedAt.Equal(dbNow.Add(-alertSettleDelay)) {
+		t.Fatalf("expected cursor at database time minus settle delay, got %+v", repo.saved)
+	}
+}
+
+func TestSavedSearchAlerts_PagesThroughNewJobs(t *testing.T) {
+	start := time.Date(2026, 3, 4, 0, 0, 0, 0, time.UTC)
+	search := repository.SavedSearch{ID: uuid.New(), UserID: uuid.New(), Title: "backend"}
+	repo := &fakeSavedSearchRepo{
+		cursor:   &repository.NewJobRef{CreatedAt: start},
+		newJobs:  newJobRefs(alertJobBatchSize+1, start),
+		searches: []repository.SavedSearch{search},
+		stored:   map[uuid.UUID]map[uuid.UUID]bool{},
+	}
+	var filters []repository.JobListFilter
+	uc := NewSavedSearchAlerts(repo, alertJobRepo{filters: &filters}, nil, nil, nil)
+
+	n, err := uc.ProcessNewJobs(context.Background())
+	if err != nil {
+		t.Fatalf("unexpected err: %v", err)
+	}
+	if n != alertJobBatchSize+1 || len(repo.stored[search.ID]) != alertJobBatchSize+1 {
+		t.Fatalf("expected every new job matched once, got %d (%d stored)", n, len(repo.stored[search.ID]))
+	}
+	if repo.pages != 2 || len(repo.saved) != 2 {
+		t.Fatalf("expected two batches each saving the cursor, got %d pages, %d saves", repo.pages, len(repo.saved))
+	}
+	if last := repo.newJobs[len(repo.newJobs)-1]; repo.saved[1] != last {
+		t.Fatalf("expected cursor at the last job, got %+v", repo.saved[1])
+	}
+
+	// Nothing new: the cursor stays put.
+	if n, err := uc.ProcessNewJobs(context.Background()); err != nil || n != 0 || len(repo.saved) != 2 {
+		t.Fatalf("expected an idle rerun, got %d matches, err %v, %d saves", n, err, len(repo.saved))
+	}
+}
+
+func TestSavedSearchAlerts_ExclusionsAndNewMatchesOnly(t *testing.T) {
+	start := time.Date(2026, 3, 4, 0, 0, 0, 0, time.UTC)
+	jobs := newJobRefs(3, start)
+	dismissed, known, fresh := jobs[0].ID, jobs[1].ID, jobs[2].ID
+	search := repository.SavedSearch{ID: uuid.New(), UserID: uuid.New(), Name: "Backend", Title: "backend", Notify: NotifyInstant}
+	repo := &fakeSavedSearchRepo{
+		cursor:   &repository.NewJobRef{CreatedAt: start},
+		newJobs:  jobs,
+		searches: []repository.SavedSearch{search},
+		stored:   map[uuid.UUID]map[uuid.UUID]bool{search.ID: {known: true}},
+	}
+	var filters []repository.JobListFilter
+	excl := stubExclusions{search.UserID: {JobIDs: []uuid.UUID{dismissed}, CompanyKeys: []string{"acme"}}}
+	notifier := &fakeNotifier{}
+	uc := NewSavedSearchAlerts(repo, alertJobRepo{filters: &filters}, excl, notifier, nil)
+
+	n, err := uc.ProcessNewJobs(context.Background())
+	if err != nil {
+		t.Fatalf("unexpected err: %v", err)
+	}
+	if len(filters) == 0 || len(filters[0].ExcludeJobIDs) != 1 || len(filters[0].ExcludeCompanyKeys) != 1 {
+		t.Fatalf("expected the user's exclusions in the search filter, got %+v", filters)
+	}
+	if repo.stored[search.ID][dismissed] {
+		t.Fatalf("dismissed job was stored as a match")
+	}
+	if n != 1 {
+		t.Fatalf("expected only the fresh job counted, got %d", n)
+	}
+	if len(notifier.calls) != 1 || len(notifier.calls[0].jobs) != 1 || notifier.calls[0].jobs[0].JobID != fresh.String() {
+		t.Fatalf("expected one alert with the fresh job only, got %+v", notifier.calls)
+	}
+}
@@ -0,0 +1,201 @@
+package usecase
+
+import (
+	"context"
+	"errors"
+	"strings"
+
+	"skill-sync/internal/repository"
+
+	"github.com/google/uuid"
+)
+
+var ErrSavedSearchNotFound = errors.New("saved search not found")
+
+const (
+	NotifyInstant = "instant"
+	NotifyNone    = "none"
+
+	maxSavedSearchesPerUser = 20
+	maxSavedSearchNameLen   = 100
+)
+
+type SavedSearchInput struct {
+	Name   string
+	Query  JobListParams
+	Notify string
+}
+
+type SavedSearchUsecase interface {
+	Create(ctx context.Context, userID uuid.UUID, in SavedSearchInput) (repository.SavedSearch, error)
+	Get(ctx context.Context, userID, id uuid.UUID) (repository.SavedSearch, error)
+	List(ctx context.Context, userID uuid.UUID) ([]repository.SavedSearch, error)
+	Update(ctx context.Context, userID, id uuid.UUID, in SavedSearchInput) (repository.SavedSearch, error)
+	Delete(ctx context.Context, userID, id uuid.UUID) error
+	ListMatches(ctx context.Context, userID, id uuid.UUID, unseenOnly bool, limit, offset int) ([]repository.SavedSearchMatch, error)
+	MarkMatchesSeen(ctx context.Context, userID, id uuid.UUID) error
+}
+
+type SavedSearches struct {
+	repo repository.SavedSearchRepository
+}
+
+func NewSavedSearchUsecase(repo repository.SavedSearchRepository) *SavedSearches {
+	return &SavedSearches{repo: repo}
+}
+
+func (u *SavedSearches) Create(ctx context.Context, userID uuid.UUID, in SavedSearchInput) (repository.SavedSearch, error) {
+	if userID == uuid.Nil {
+		return repository.SavedSearch{}, ErrUnauthorized
+	}
+	s, err := newSavedSearch(userID, in)
+	if err != nil {
+		return repository.SavedSearch{}, err
+	}
+
+	n, err := u.repo.CountByUser(ctx, userID)
+	if err != nil {
+		return repository.SavedSearch{}, ErrInternal
+	}
+	if n >= maxSavedSearchesPerUser {
+		return repository.SavedSearch{}, ErrInvalidInput
+	}
+
+	id, err := u.repo.Create(ctx, s)
+	if err != nil {
+		return repository.SavedSearch{}, ErrInternal
+	}
+	return u.Get(ctx, userID, id)
+}
+
+func (u *SavedSearches) Get(ctx context.Context, userID, id uuid.UUID) (repository.SavedSearch, error) {
+	if userID == uuid.Nil {
+		return repository.SavedSearch{}, ErrUnauthorized
+	}
+	s, ok, err := u.repo.FindByID(ctx, userID, id)
+	if err != nil {
+		return repository.SavedSearch{}, ErrInternal
+	}
+	if !ok {
+		return repository.SavedSearch{}, ErrSavedSearchNotFound
+	}
+	return s, nil
+}
+
+func (u *SavedSearches) List(ctx context.Context, userID uuid.UUID) ([]repository.SavedSearch, error) {
+	if userID == uuid.Nil {
+		return nil, ErrUnauthorized
+	}
+	items, err := u.repo.ListByUser(ctx, userID)
+	if err != nil {
+		return nil, ErrInternal
+	}
+	return items, nil
+}
+
+func (u *SavedSearches) Update(ctx context.Context, userID, id uuid.UUID, in SavedSearchInput) (repository.SavedSearch, error) {
+	if userID == uuid.Nil {
+		return repository.SavedSearch{}, ErrUnauthorized
+	}
+	s, err := newSavedSearch(userID, in)
+	if err != nil {
+		return repository.SavedSearch{}, err
+	}
+	s.ID = id
+
+	ok, err := u.repo.Update(ctx, s)
+	if err != nil {
+		return repository.SavedSearch{}, ErrInternal
+	}
+	if !ok {
+		return repository.SavedSearch{}, ErrSavedSearchNotFound
+	}
+	return u.Get(ctx, userID, id)
+}
+
+func (u *SavedSearches) Delete(ctx context.Context, userID, id uuid.UUID) error {
+	if userID == uuid.Nil {
+		return ErrUnauthorized
+	}
+	ok, err := u.repo.Delete(ctx, userID, id)
+	if err != nil {
+		return ErrInternal
+	}
+	if !ok {
+		return ErrSavedSearchNotFound
+	}
+	return nil
+}
+
+func (u *SavedSearches) ListMatches(ctx context.Context, userID, id uuid.UUID, unseenOnly bool, limit, offset int) ([]repository.SavedSearchMatch, error) {
+	if _, err := u.Get(ctx, userID, id); err != nil {
+		return nil, err
+	}
+	if limit <= 0 {
+		limit = 20
+	}
+	if limit > 100 {
+		limit = 100
+	}
+	if offset < 0 {
+		offset = 0
+	}
+	items, err := u.repo.ListMatches(ctx, id, unseenOnly, limit, offset)
+	if err != nil {
+		return nil, ErrInternal
+	}
+	return items, nil
+}
+
+func (u *SavedSearches) MarkMatchesSeen(ctx context.Context, userID, id uuid.UUID) error {
+	if _, err := u.Get(ctx, userID, id); err != nil {
+		return err
+	}
+	if _, err := u.repo.MarkMatchesSeen(ctx, id); err != nil {
+		return ErrInternal
+	}
+	return nil
+}
+
+// newSavedSearch validates the query with the same rules as /jobs.
+func newSavedSearch(userID uuid.UUID, in SavedSearchInput) (repository.SavedSearch, error) {
+	name := strings.TrimSpace(in.Name)
+	if name == "" || len(name) > maxSavedSearchNameLen {
+		return repository.SavedSearch{}, ErrInvalidInput
+	}
+	notify := strings.ToLower(strings.TrimSpace(in.Notify))
+	if notify == "" {
+		notify = NotifyInstant
+	}
+	if notify != NotifyInstant && notify != NotifyNone {
+		return repository.SavedSearch{}, ErrInvalidInput
+	}
+
+	skills := make([]string, 0, len(in.Query.Skills))
+	for _, s := range in.Query.Skills {
+		s = strings.TrimSpace(s)
+		if s == "" {
+			continue
+		}
+		skills = append(skills, s)
+	}
+	seniority, err := normalizeSeniorityFilter(in.Query.Seniority)
+	if err != nil {
+		return repository.SavedSearch{}, err
+	}
+
+	s := repository.SavedSearch{
+		UserID:      userID,
+		Name:        name,
+		Title:       strings.TrimSpace(in.Query.Title),
+		CompanyName: strings.TrimSpace(in.Query.CompanyName),
+		Location:    strings.TrimSpace(in.Query.Location),
+		Skills:      skills,
+		Seniority:   seniority,
+		Notify:      notify,
+	}
+	if s.Title == "" && s.CompanyName == "" && s.Location == "" && len(s.Skills) == 0 && len(s.Seniority) == 0 {
+		return repository.SavedSearch{}, ErrInvalidInput
+	}
+	return s, nil
+}
@@ -0,0 +1,87 @@
+package usecase
+
+import (
+	"context"
+	"log"
+	"strings"
+
+	"skill-sync/internal/domain/matching"
+	"skill-sync/internal/repository"
+)
+
+type ScoringProfiles struct {
+	repo     repository.ScoringProfileRepository
+	registry *matching.ProfileRegistry
+	logger   *log.Logger
+}
+
+func NewScoringProfiles(repo repository.ScoringProfileRepository, registry *matching.ProfileRegistry, logger *log.Logger) *ScoringProfiles {
+	if registry == nil {
+		registry = matching.NewProfileRegistry()
+	}
+	return &ScoringProfiles{repo: repo, registry: registry, logger: logger}
+}
+
+func (u *ScoringProfiles) Registry() *matching.ProfileRegistry {
+	if u == nil {
+		return nil
+	}
+	return u.registry
+}
+
+// Load registers the stored profiles and picks the active one: the preferred
+// name if it is known, otherwise the DB default, otherwise the built-in V2.
+func (u *ScoringProfiles) Load(ctx context.Context, preferred string) {
+	if u == nil {
+		return
+	}
+
+	dbDefault := ""
+	if u.repo != nil {
+		rows, err := u.repo.ListActive(ctx)
+		if err != nil {
+			u.logf("[Matching] Scoring profiles load failed, using built-ins: %v", err)
+		}
+		for _, row := range rows {
+			p := matching.Profile{
+				Name:             row.Name,
+				Version:          row.Version,
+				MandatoryWeight:  row.MandatoryWeight,
+				OptionalWeight:   row.OptionalWeight,
+				ExperienceWeight: row.ExperienceWeight,
+				ImportanceMode:   row.ImportanceMode,
+				PartialCurve:     row.PartialCreditCurve,
+				YearsCap:         row.YearsCap,
+				RelatedCredit:    row.RelatedCredit,
+				SeniorityPenalty: row.SeniorityPenalty,
+			}
+			if err := u.registry.Register(p); err != nil {
+				u.logf("[Matching] Scoring profile skipped name=%s version=%d: %v", row.Name, row.Version, err)
+				continue
+			}
+			if row.IsDefault && dbDefault == "" {
+				dbDefault = row.Name
+			}
+		}
+	}
+
+	for _, name := range []string{strings.TrimSpace(preferred), dbDefault, matching.ProfileDefaultV2} {
+		if name == "" {
+			continue
+		}
+		if err := u.registry.SetActive(name); err != nil {
+			u.logf("[Matching] Scoring profile %q not available: %v", name, err)
+			continue
+		}
+		break
+	}
+
+	active := u.registry.Active()
+	u.logf("[Matching] Scoring profile active name=%s version=%d", active.Name, active.Version)
+}
+
+func (u *ScoringProfiles) logf(format string, args ...any) {
+	if u.logger != nil {
+		u.logger.Printf(format, args...)
+	}
+}
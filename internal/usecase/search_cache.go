@@ -10,4 +10,6 @@ type SearchCache interface {
 	SetJSON(ctx context.Context, key string, value any, ttl time.Duration) error
 	Delete(ctx context.Context, key string) error
 	SetIfNotExists(ctx context.Context, key string, value string, ttl time.Duration) (bool, error)
+	IndexSearchKey(ctx context.Context, key string, terms []string, ttl time.Duration) error
+	InvalidateSearchTerms(ctx context.Context, terms []string) error
 }
@@ -4,6 +4,7 @@ import (
 	"crypto/sha256"
 	"encoding/hex"
 	"encoding/json"
+	"sort"
 	"strings"
 )
 
@@ -12,6 +13,21 @@ type jobSearchCacheKeyInput struct {
 	CompanyName string   `json:"company_name"`
 	Location    string   `json:"location"`
 	Skills      []string `json:"skills"`
+	Seniority   []string `json:"seniority,omitempty"`
+	Excluded    []string `json:"excluded,omitempty"`
+	Autocorrect bool     `json:"autocorrect,omitempty"`
+	Locations   []string `json:"locations,omitempty"`
+	Companies   []string `json:"companies,omitempty"`
+	Sources     []string `json:"sources,omitempty"`
+	Employment  []string `json:"employment_types,omitempty"`
+	Posted      []string `json:"posted,omitempty"`
+	Near        string   `json:"near,omitempty"`
+	RadiusKm    float64  `json:"radius_km,omitempty"`
+	SalaryMin   int      `json:"salary_min,omitempty"`
+	SalaryMax   int      `json:"salary_max,omitempty"`
+	Sort        string   `json:"sort,omitempty"`
+	Cursor      string   `json:"cursor,omitempty"`
+	Total       string   `json:"total,omitempty"`
 	Limit       int      `json:"limit"`
 	Offset      int      `json:"offset"`
 }
@@ -38,16 +54,46 @@ func JobsSearchCacheKey(params JobListParams) string {
 		CompanyName: normalizeSearchValue(params.CompanyName),
 		Location:    normalizeSearchValue(params.Location),
 		Skills:      skills,
+		Seniority:   params.Seniority,
+		Autocorrect: params.Autocorrect,
+		Locations:   params.Locations,
+		Companies:   params.Companies,
+		Sources:     params.Sources,
+		Employment:  params.EmploymentTypes,
+		Posted:      params.Posted,
+		Near:        params.Near,
+		RadiusKm:    params.RadiusKm,
+		SalaryMin:   params.SalaryMin,
+		SalaryMax:   params.SalaryMax,
+		Sort:        params.Sort,
+		Cursor:      params.Cursor,
+		Total:       params.Total,
 		Limit:       params.Limit,
 		Offset:      params.Offset,
 	}
 
+	// Keyed on the exclusions rather than the user so that a dismiss or undo
+	// takes effect immediately and users hiding the same things share entries.
+	for _, id := range params.exclusions.JobIDs {
+		in.Excluded = append(in.Excluded, "job:"+id.String())
+	}
+	for _, k := range params.exclusions.CompanyKeys {
+		in.Excluded = append(in.Excluded, "company:"+k)
+	}
+	sort.Strings(in.Excluded)
+
 	b, _ := json.Marshal(in)
 	sum := sha256.Sum256(b)
 	h := hex.EncodeToString(sum[:])
 	return "jobs:search:" + h
 }
 
+// JobsSearchFacetsKey is shared by every page and sort order of a search.
+func JobsSearchFacetsKey(params JobListParams) string {
+	params.Limit, params.Offset, params.Cursor, params.Total, params.Sort = 0, 0, "", TotalNone, ""
+	return "jobs:search:facets:" + strings.TrimPrefix(JobsSearchCacheKey(params), "jobs:search:")
+}
+
 func JobsSearchLockKey(searchKey string) string {
 	searchKey = strings.TrimSpace(searchKey)
 	if strings.HasPrefix(searchKey, "jobs:search:") {
@@ -0,0 +1,215 @@
+package usecase
+
+import (
+	"context"
+	"log"
+	"sync"
+	"sync/atomic"
+	"time"
+	"unicode/utf8"
+
+	"skill-sync/internal/repository"
+	"skill-sync/internal/search"
+)
+
+const (
+	defaultSuggestLimit = 5
+	maxSuggestLimit     = 10
+	maxSuggestQueryLen  = 64
+	suggestCacheTTL     = 5 * time.Minute
+	// suggestMaxAge forces a rebuild so search popularity is picked up even
+	// when the catalogue is quiet.
+	suggestMaxAge = time.Hour
+	// maxPendingSearches bounds the in-memory counts between flushes.
+	maxPendingSearches = 10000
+)
+
+type SuggestResult struct {
+	Query  string
+	Groups map[string][]search.Suggestion
+}
+
+type SearchSuggestUsecase interface {
+	Suggest(ctx context.Context, query string, limit int) (SuggestResult, error)
+	RecordSearch(query string)
+}
+
+type suggestCache interface {
+	GetJSON(ctx context.Context, key string, out any) (bool, error)
+	SetJSON(ctx context.Context, key string, value any, ttl time.Duration) error
+	DeleteByPattern(ctx context.Context, pattern string) error
+}
+
+// SearchSuggest serves autocomplete from an in-memory prefix index that is
+// rebuilt when the set of active jobs changes.
+type SearchSuggest struct {
+	repo   repository.SearchSuggestRepository
+	cache  suggestCache
+	logger *log.Logger
+
+	index atomic.Pointer[search.SuggestIndex]
+
+	pendingMu sync.Mutex
+	pending   map[string]int
+
+	buildMu sync.Mutex
+	version repository.CatalogueVersion
+	builtAt time.Time
+}
+
+func NewSearchSuggestUsecase(repo repository.SearchSuggestRepository, cache suggestCache, logger *log.Logger) *SearchSuggest {
+	return &SearchSuggest{repo: repo, cache: cache, logger: logger, pending: map[string]int{}}
+}
+
+func (u *SearchSuggest) Suggest(ctx context.Context, query string, limit int) (SuggestResult, error) {
+	if limit == 0 {
+		limit = defaultSuggestLimit
+	}
+	if limit < 0 || limit > maxSuggestLimit {
+		return SuggestResult{}, ErrInvalidInput
+	}
+	norm := search.NormalizeQuery(query)
+	if norm == "" || utf8.RuneCountInString(norm) > maxSuggestQueryLen {
+		return SuggestResult{}, ErrInvalidInput
+	}
+
+	// Entries always hold maxSuggestLimit items per type so every limit can
+	// share them; the key matches what Redis.InvalidateCacheByKeyword drops.
+	key := "suggest:" + norm
+	var res SuggestResult
+	hit := false
+	if u.cache != nil {
+		ok, err := u.cache.GetJSON(ctx, key, &res)
+		hit = err == nil && ok
+	}
+	if !hit {
+		res = SuggestResult{Query: norm, Groups: u.index.Load().Suggest(norm, maxSuggestLimit)}
+		if u.cache != nil {
+			_ = u.cache.SetJSON(ctx, key, res, suggestCacheTTL)
+		}
+	}
+
+	out := SuggestResult{Query: res.Query, Groups: make(map[string][]search.Suggestion, len(search.SuggestTypes))}
+	for _, typ := range search.SuggestTypes {
+		items := res.Groups[typ]
+		if items == nil {
+			items = []search.Suggestion{}
+		}
+		if len(items) > limit {
+			items = items[:limit]
+		}
+		out.Groups[typ] = items
+	}
+	return out, nil
+}
+
+// RecordSearch counts a title search towards autocomplete popularity. Counts
+// are buffered and written on the next refresh.
+func (u *SearchSuggest) RecordSearch(query string) {
+	if u == nil {
+		return
+	}
+	norm := search.NormalizeQuery(query)
+	if norm == "" || utf8.RuneCountInString(norm) > maxSuggestQueryLen {
+		return
+	}
+	u.pendingMu.Lock()
+	defer u.pendingMu.Unlock()
+	if _, ok := u.pending[norm]; !ok && len(u.pending) >= maxPendingSearches {
+		return
+	}
+	u.pending[norm]++
+}
+
+// Rebuild reloads terms and popularity and swaps in a new index.
+func (u *SearchSuggest) Rebuild(ctx context.Context) error {
+	if u == nil || u.repo == nil {
+		return nil
+	}
+	u.buildMu.Lock()
+	defer u.buildMu.Unlock()
+
+	u.flushSearches(ctx)
+	version, err := u.repo.CatalogueVersion(ctx)
+	if err != nil {
+		u.logf("[Suggest] Rebuild failed: %v", err)
+		return err
+	}
+	rows, err := u.repo.ListSuggestTerms(ctx)
+	if err != nil {
+		u.logf("[Suggest] Rebuild failed: %v", err)
+		return err
+	}
+	popularity, err := u.repo.ListQueryPopularity(ctx, 0)
+	if err != nil {
+		u.logf("[Suggest] Popularity load failed: %v", err)
+		popularity = map[string]int{}
+	}
+
+	terms := make([]search.SuggestTerm, 0, len(rows))
+	for _, r := range rows {
+		terms = append(terms, search.SuggestTerm{
+			Type:       r.Type,
+			Text:       r.Text,
+			Count:      r.Count,
+			Popularity: popularity[search.NormalizeQuery(r.Text)],
+		})
+	}
+	idx := search.NewSuggestIndex(terms)
+	u.index.Store(idx)
+	u.version = version
+	u.builtAt = time.Now()
+	if u.cache != nil {
+		_ = u.cache.DeleteByPattern(ctx, "suggest:*")
+	}
+	u.logf("[Suggest] Index built terms=%d", idx.Size())
+	return nil
+}
+
+// Run flushes search counts and rebuilds the index when active jobs change.
+func (u *SearchSuggest) Run(ctx context.Context, interval time.Duration) {
+	if u == nil || u.repo == nil || interval <= 0 {
+		return
+	}
+	t := time.NewTicker(interval)
+	defer t.Stop()
+	for {
+		select {
+		case <-ctx.Done():
+			return
+		case <-t.C:
+			v, err := u.repo.CatalogueVersion(ctx)
+			if err != nil {
+				u.logf("[Suggest] Version check failed: %v", err)
+				continue
+			}
+			u.buildMu.Lock()
+			stale := v != u.version || time.Since(u.builtAt) > suggestMaxAge
+			u.buildMu.Unlock()
+			if stale {
+				_ = u.Rebuild(ctx)
+			} else {
+				u.flushSearches(ctx)
+			}
+		}
+	}
+}
+
+func (u *SearchSuggest) flushSearches(ctx context.Context) {
+	u.pendingMu.Lock()
+	counts := u.pending
+	u.pending = map[string]int{}
+	u.pendingMu.Unlock()
+	if len(counts) == 0 {
+		return
+	}
+	if err := u.repo.IncrementQueryCounts(ctx, counts); err != nil {
+		u.logf("[Suggest] Search count flush failed queries=%d: %v", len(counts), err)
+	}
+}
+
+func (u *SearchSuggest) logf(format string, args ...any) {
+	if u.logger != nil {
+		u.logger.Printf(format, args...)
+	}
+}
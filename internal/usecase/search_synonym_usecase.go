@@ -0,0 +1,264 @@
+package usecase
+
+import (
+	"context"
+	"errors"
+	"log"
+	"strings"
+	"sync"
+	"time"
+
+	"skill-sync/internal/repository"
+	"skill-sync/internal/search"
+
+	"github.com/google/uuid"
+)
+
+var (
+	ErrSynonymNotFound = errors.New("synonym not found")
+	ErrSynonymExists   = errors.New("synonym already exists")
+)
+
+const maxSynonymsPerTerm = 20
+
+type SearchSynonymInput struct {
+	Language string
+	Term     string
+	Synonyms []string
+	TwoWay   bool
+}
+
+type SearchSynonymUsecase interface {
+	ListSynonyms(ctx context.Context) ([]repository.SearchSynonym, error)
+	CreateSynonym(ctx context.Context, in SearchSynonymInput) (repository.SearchSynonym, error)
+	UpdateSynonym(ctx context.Context, id uuid.UUID, in SearchSynonymInput) (repository.SearchSynonym, error)
+	DeleteSynonym(ctx context.Context, id uuid.UUID) error
+}
+
+type searchTermInvalidator interface {
+	InvalidateSearchTerms(ctx context.Context, terms []string) error
+}
+
+// SearchSynonyms owns the query expansion dictionary: admins edit it here and
+// every instance picks up edits through Run.
+type SearchSynonyms struct {
+	repo   repository.SearchSynonymRepository
+	cache  searchTermInvalidator
+	logger *log.Logger
+
+	mu      sync.Mutex
+	version repository.SynonymVersion
+}
+
+func NewSearchSynonymUsecase(repo repository.SearchSynonymRepository, cache searchTermInvalidator, logger *log.Logger) *SearchSynonyms {
+	return &SearchSynonyms{repo: repo, cache: cache, logger: logger}
+}
+
+// Reload replaces the dictionary used by search.ExpandQuery. On failure the
+// previous dictionary stays in place.
+func (u *SearchSynonyms) Reload(ctx context.Context) error {
+	if u == nil || u.repo == nil {
+		return nil
+	}
+	u.mu.Lock()
+	defer u.mu.Unlock()
+
+	version, err := u.repo.Version(ctx)
+	if err != nil {
+		u.logf("[Search] Synonym reload failed: %v", err)
+		return err
+	}
+	items, err := u.repo.ListAll(ctx)
+	if err != nil {
+		u.logf("[Search] Synonym reload failed: %v", err)
+		return err
+	}
+
+	entries := make([]search.SynonymEntry, 0, len(items))
+	for _, it := range items {
+		entries = append(entries, search.SynonymEntry{
+			Language: it.Language,
+			Term:     it.Term,
+			Synonyms: it.Synonyms,
+			TwoWay:   it.TwoWay,
+		})
+	}
+	d := search.NewDictionary(entries)
+	search.SetDictionary(d)
+	u.version = version
+	u.logf("[Search] Synonyms loaded entries=%d terms=%d", len(items), d.Size())
+	return nil
+}
+
+// Run reloads the dictionary whenever another instance has changed it.
+func (u *SearchSynonyms) Run(ctx context.Context, interval time.Duration) {
+	if u == nil || u.repo == nil || interval <= 0 {
+		return
+	}
+	t := time.NewTicker(interval)
+	defer t.Stop()
+	for {
+		select {
+		case <-ctx.Done():
+			return
+		case <-t.C:
+			v, err := u.repo.Version(ctx)
+			if err != nil {
+				u.logf("[Search] Synonym version check failed: %v", err)
+				continue
+			}
+			u.mu.Lock()
+			changed := v != u.version
+			u.mu.Unlock()
+			if changed {
+				_ = u.Reload(ctx)
+			}
+		}
+	}
+}
+
+func (u *SearchSynonyms) ListSynonyms(ctx context.Context) ([]repository.SearchSynonym, error) {
+	items, err := u.repo.ListAll(ctx)
+	if err != nil {
+		return nil, ErrInternal
+	}
+	return items, nil
+}
+
+func (u *SearchSynonyms) CreateSynonym(ctx context.Context, in SearchSynonymInput) (repository.SearchSynonym, error) {
+	clean, err := normalizeSynonymInput(in)
+	if err != nil {
+		return repository.SearchSynonym{}, err
+	}
+	out, err := u.repo.Create(ctx, clean)
+	if err != nil {
+		if isUniqueViolation(err) {
+			return repository.SearchSynonym{}, ErrSynonymExists
+		}
+		return repository.SearchSynonym{}, ErrInternal
+	}
+	u.changed(ctx, out)
+	return out, nil
+}
+
+func (u *SearchSynonyms) UpdateSynonym(ctx context.Context, id uuid.UUID, in SearchSynonymInput) (repository.SearchSynonym, error) {
+	if id == uuid.Nil {
+		return repository.SearchSynonym{}, ErrInvalidInput
+	}
+	clean, err := normalizeSynonymInput(in)
+	if err != nil {
+		return repository.SearchSynonym{}, err
+	}
+	prev, ok, err := u.repo.FindByID(ctx, id)
+	if err != nil {
+		return repository.SearchSynonym{}, ErrInternal
+	}
+	if !ok {
+		return repository.SearchSynonym{}, ErrSynonymNotFound
+	}
+	out, ok, err := u.repo.Update(ctx, id, clean)
+	if err != nil {
+		if isUniqueViolation(err) {
+			return repository.SearchSynonym{}, ErrSynonymExists
+		}
+		return repository.SearchSynonym{}, ErrInternal
+	}
+	if !ok {
+		return repository.SearchSynonym{}, ErrSynonymNotFound
+	}
+	u.changed(ctx, prev, out)
+	return out, nil
+}
+
+func (u *SearchSynonyms) DeleteSynonym(ctx context.Context, id uuid.UUID) error {
+	if id == uuid.Nil {
+		return ErrInvalidInput
+	}
+	deleted, ok, err := u.repo.Delete(ctx, id)
+	if err != nil {
+		return ErrInternal
+	}
+	if !ok {
+		return ErrSynonymNotFound
+	}
+	u.changed(ctx, deleted)
+	return nil
+}
+
+// changed reloads the local dictionary and drops cached searches whose
+// expansion used any of the affected terms.
+func (u *SearchSynonyms) changed(ctx context.Context, items ...repository.SearchSynonym) {
+	_ = u.Reload(ctx)
+	if u.cache == nil {
+		return
+	}
+	terms := synonymLookupTerms(items...)
+	if err := u.cache.InvalidateSearchTerms(ctx, terms); err != nil {
+		u.logf("[Search] Synonym cache invalidation failed terms=%v: %v", terms, err)
+	}
+}
+
+// synonymLookupTerms lists the dictionary keys an entry defines: its term and,
+// for two-way entries, every synonym, each also in the compact form that
+// ExpandQuery recognises ("office boy" -> "officeboy").
+func synonymLookupTerms(items ...repository.SearchSynonym) []string {
+	out := make([]string, 0)
+	seen := map[string]struct{}{}
+	add := func(t string) {
+		for _, k := range []string{t, strings.ReplaceAll(t, " ", "")} {
+			if k == "" {
+				continue
+			}
+			if _, ok := seen[k]; ok {
+				continue
+			}
+			seen[k] = struct{}{}
+			out = append(out, k)
+		}
+	}
+	for _, it := range items {
+		add(search.NormalizeQuery(it.Term))
+		if !it.TwoWay {
+			continue
+		}
+		for _, s := range it.Synonyms {
+			add(search.NormalizeQuery(s))
+		}
+	}
+	return out
+}
+
+func normalizeSynonymInput(in SearchSynonymInput) (repository.SearchSynonymInput, error) {
+	lang := strings.ToLower(strings.TrimSpace(in.Language))
+	if !search.IsValidLanguage(lang) {
+		return repository.SearchSynonymInput{}, ErrInvalidInput
+	}
+	term := search.NormalizeQuery(in.Term)
+	if term == "" {
+		return repository.SearchSynonymInput{}, ErrInvalidInput
+	}
+
+	syns := make([]string, 0, len(in.Synonyms))
+	seen := map[string]struct{}{term: {}}
+	for _, s := range in.Synonyms {
+		s = search.NormalizeQuery(s)
+		if s == "" {
+			continue
+		}
+		if _, ok := seen[s]; ok {
+			continue
+		}
+		seen[s] = struct{}{}
+		syns = append(syns, s)
+	}
+	if len(syns) == 0 || len(syns) > maxSynonymsPerTerm {
+		return repository.SearchSynonymInput{}, ErrInvalidInput
+	}
+	return repository.SearchSynonymInput{Language: lang, Term: term, Synonyms: syns, TwoWay: in.TwoWay}, nil
+}
+
+func (u *SearchSynonyms) logf(format string, args ...any) {
+	if u.logger != nil {
+		u.logger.Printf(format, args...)
+	}
+}
@@ -0,0 +1,79 @@
+package usecase
+
+import (
+	"context"
+	"log"
+	"sync/atomic"
+	"time"
+
+	"skill-sync/internal/repository"
+	"skill-sync/internal/search"
+)
+
+type spellSuggester interface {
+	Suggest(query string) (string, bool)
+}
+
+// SearchVocabulary keeps the spelling corrector behind "did you mean" in
+// sync with the job catalogue.
+type SearchVocabulary struct {
+	repo    repository.SearchVocabularyRepository
+	speller atomic.Pointer[search.SpellCorrector]
+	logger  *log.Logger
+}
+
+func NewSearchVocabulary(repo repository.SearchVocabularyRepository, logger *log.Logger) *SearchVocabulary {
+	return &SearchVocabulary{repo: repo, logger: logger}
+}
+
+func (u *SearchVocabulary) Reload(ctx context.Context) error {
+	if u == nil || u.repo == nil {
+		return nil
+	}
+	vocab, err := u.repo.ListVocabulary(ctx)
+	if err != nil {
+		if u.logger != nil {
+			u.logger.Printf("[Search] Vocabulary reload failed: %v", err)
+		}
+		return err
+	}
+	s := search.NewSpellCorrector(vocab)
+	u.speller.Store(s)
+	if u.logger != nil {
+		u.logger.Printf("[Search] Vocabulary loaded words=%d", s.Size())
+	}
+	return nil
+}
+
+// Run rebuilds the vocabulary on an interval so newly scraped titles count.
+func (u *SearchVocabulary) Run(ctx context.Context, interval time.Duration) {
+	if u == nil || interval <= 0 {
+		return
+	}
+	t := time.NewTicker(interval)
+	defer t.Stop()
+	for {
+		select {
+		case <-ctx.Done():
+			return
+		case <-t.C:
+			_ = u.Reload(ctx)
+		}
+	}
+}
+
+// Suggest returns a corrected query when any of its words looks misspelt.
+func (u *SearchVocabulary) Suggest(query string) (string, bool) {
+	if u == nil {
+		return "", false
+	}
+	s := u.speller.Load()
+	if s == nil {
+		return "", false
+	}
+	corrected, changed := s.Correct(query)
+	if !changed {
+		return "", false
+	}
+	return corrected, true
+}
@@ -0,0 +1,258 @@
+package usecase
+
+import (
+	"context"
+	"sort"
+
+	"skill-sync/internal/domain/matching"
+	"skill-sync/internal/repository"
+
+	"github.com/google/uuid"
+)
+
+const (
+	defaultSkillGapJobs      = 20
+	maxSkillGapJobs          = 100
+	defaultSkillGapThreshold = 70
+	defaultSkillGapLimit     = 10
+	skillGapFallbackJobs     = 500
+)
+
+type SkillGapParams struct {
+	Jobs      int
+	Threshold int
+	Limit     int
+}
+
+type SkillGapJob struct {
+	JobID            uuid.UUID
+	Title            string
+	CompanyName      string
+	CurrentScore     int
+	SimulatedScore   int
+	MandatoryCleared bool
+	CrossedThreshold bool
+}
+
+type SkillGapItem struct {
+	SkillID      uuid.UUID
+	SkillName    string
+	TotalPoints  int
+	JobsAffected int
+	JobsUnlocked int
+	MandatoryIn  int
+	Jobs         []SkillGapJob
+}
+
+type SkillGapPlan struct {
+	JobsConsidered int
+	Threshold      int
+	Skills         []SkillGapItem
+}
+
+type SkillGapUsecase interface {
+	GetSkillGaps(ctx context.Context, userID uuid.UUID, params SkillGapParams) (SkillGapPlan, error)
+}
+
+type SkillGap struct {
+	matches     repository.JobMatchRepository
+	jobsQry     repository.JobQueryRepository
+	jobs        repository.JobRepository
+	jobSkillsV2 repository.JobSkillV2Repository
+	userSkills  repository.UserSkillRepository
+	engines     MatchEngineProvider
+	seniority   repository.SeniorityRepository
+}
+
+func NewSkillGapUsecase(
+	matches repository.JobMatchRepository,
+	jobsQry repository.JobQueryRepository,
+	jobs repository.JobRepository,
+	jobSkillsV2 repository.JobSkillV2Repository,
+	userSkills repository.UserSkillRepository,
+	engines MatchEngineProvider,
+	seniority repository.SeniorityRepository,
+) *SkillGap {
+	return &SkillGap{matches: matches, jobsQry: jobsQry, jobs: jobs, jobSkillsV2: jobSkillsV2, userSkills: userSkills, engines: engines, seniority: seniority}
+}
+
+type scoredJob struct {
+	id      uuid.UUID
+	reqs    []matching.JobRequirementV2
+	jobRank int
+	result  matching.ResultV2
+}
+
+func (u *SkillGap) GetSkillGaps(ctx context.Context, userID uuid.UUID, params SkillGapParams) (SkillGapPlan, error) {
+	if userID == uuid.Nil {
+		return SkillGapPlan{}, ErrUnauthorized
+	}
+	if params.Jobs <= 0 {
+		params.Jobs = defaultSkillGapJobs
+	}
+	if params.Jobs > maxSkillGapJobs {
+		params.Jobs = maxSkillGapJobs
+	}
+	if params.Threshold <= 0 || params.Threshold > 100 {
+		params.Threshold = defaultSkillGapThreshold
+	}
+	if params.Limit <= 0 {
+		params.Limit = defaultSkillGapLimit
+	}
+
+	us, err := u.userSkills.FindByUserID(ctx, userID)
+	if err != nil {
+		return SkillGapPlan{}, ErrInternal
+	}
+	if len(us) == 0 {
+		return SkillGapPlan{}, ErrUserSkillProfileEmpty
+	}
+	userSkills := toEngineUserSkills(us)
+
+	candidates, err := u.candidateJobIDs(ctx, userID, params.Jobs)
+	if err != nil {
+		return SkillGapPlan{}, err
+	}
+	if len(candidates) == 0 {
+		return SkillGapPlan{}, ErrNoJobsFound
+	}
+
+	reqsByJob, err := u.jobSkillsV2.FindByJobIDsV2(ctx, candidates)
+	if err != nil {
+		return SkillGapPlan{}, ErrInternal
+	}
+
+	userRank := userSeniorityRank(ctx, u.seniority, userID)
+	jobRanks := map[uuid.UUID]int{}
+	if userRank > 0 {
+		jobRanks = jobSeniorityRanks(ctx, u.seniority, candidates)
+	}
+
+	engine := u.engines.Engine()
+	scored := make([]scoredJob, 0, len(candidates))
+	for _, id := range candidates {
+		reqs := toEngineRequirements(reqsByJob[id])
+		if len(reqs) == 0 {
+			continue
+		}
+		jobRank := jobRanks[id]
+		scored = append(scored, scoredJob{id: id, reqs: reqs, jobRank: jobRank, result: engine.ScoreWithSeniority(userSkills, reqs, userRank, jobRank)})
+	}
+	sort.SliceStable(scored, func(i, j int) bool { return scored[i].result.MatchScore > scored[j].result.MatchScore })
+	if len(scored) > params.Jobs {
+		scored = scored[:params.Jobs]
+	}
+
+	titles := map[uuid.UUID]repository.Job{}
+	ids := make([]uuid.UUID, 0, len(scored))
+	for _, sj := range scored {
+		ids = append(ids, sj.id)
+	}
+	if rows, err := u.jobs.FindByIDs(ctx, ids); err == nil {
+		for _, j := range rows {
+			titles[j.ID] = j
+		}
+	}
+
+	bySkill := map[uuid.UUID]*SkillGapItem{}
+	for _, sj := range scored {
+		for _, ms := range sj.result.MissingSkills {
+			req, ok := findRequirement(sj.reqs, ms.SkillID)
+			if !ok {
+				continue
+			}
+
+			simulated := append(append(make([]matching.UserSkillV2, 0, len(userSkills)+1), userSkills...), matching.UserSkillV2{
+				SkillID:          req.SkillID,
+				SkillName:        req.SkillName,
+				ProficiencyLevel: req.ResolvedLevel(),
+				YearsExperience:  req.ResolvedYears(),
+			})
+			next := engine.ScoreWithSeniority(simulated, sj.reqs, userRank, sj.jobRank)
+
+			item, ok := bySkill[ms.SkillID]
+			if !ok {
+				item = &SkillGapItem{SkillID: ms.SkillID, SkillName: ms.SkillName}
+				bySkill[ms.SkillID] = item
+			}
+
+			job := titles[sj.id]
+			gj := SkillGapJob{
+				JobID:            sj.id,
+				Title:            job.Title,
+				CompanyName:      job.Company,
+				CurrentScore:     sj.result.MatchScore,
+				SimulatedScore:   next.MatchScore,
+				MandatoryCleared: sj.result.MandatoryMissing && !next.MandatoryMissing,
+				CrossedThreshold: sj.result.MatchScore < params.Threshold && next.MatchScore >= params.Threshold,
+			}
+			item.TotalPoints += next.MatchScore - sj.result.MatchScore
+			item.JobsAffected++
+			if ms.IsMandatory {
+				item.MandatoryIn++
+			}
+			if gj.MandatoryCleared || gj.CrossedThreshold {
+				item.JobsUnlocked++
+			}
+			item.Jobs = append(item.Jobs, gj)
+		}
+	}
+
+	items := make([]SkillGapItem, 0, len(bySkill))
+	for _, it := range bySkill {
+		sort.SliceStable(it.Jobs, func(i, j int) bool {
+			return it.Jobs[i].SimulatedScore-it.Jobs[i].CurrentScore > it.Jobs[j].SimulatedScore-it.Jobs[j].CurrentScore
+		})
+		items = append(items, *it)
+	}
+	sort.SliceStable(items, func(i, j int) bool {
+		if items[i].JobsUnlocked != items[j].JobsUnlocked {
+			return items[i].JobsUnlocked > items[j].JobsUnlocked
+		}
+		if items[i].TotalPoints != items[j].TotalPoints {
+			return items[i].TotalPoints > items[j].TotalPoints
+		}
+		return items[i].SkillName < items[j].SkillName
+	})
+	if len(items) > params.Limit {
+		items = items[:params.Limit]
+	}
+
+	return SkillGapPlan{JobsConsidered: len(scored), Threshold: params.Threshold, Skills: items}, nil
+}
+
+// candidateJobIDs prefers the user's stored matches; when the pipeline has not
+// produced any yet it falls back to every active job with extracted skills.
+func (u *SkillGap) candidateJobIDs(ctx context.Context, userID uuid.UUID, n int) ([]uuid.UUID, error) {
+	if u.matches != nil {
+		rows, err := u.matches.ListTopByUser(ctx, userID, n)
+		if err != nil {
+			return nil, ErrInternal
+		}
+		if len(rows) > 0 {
+			out := make([]uuid.UUID, 0, len(rows))
+			for _, r := range rows {
+				out = append(out, r.JobID)
+			}
+			return out, nil
+		}
+	}
+
+	if u.jobsQry == nil {
+		return nil, nil
+	}
+	ids, err := u.jobsQry.ListJobIDsWithSkills(ctx, skillGapFallbackJobs, 0)
+	if err != nil {
+		return nil, ErrInternal
+	}
+	return ids, nil
+}
+
+func findRequirement(reqs []matching.JobRequirementV2, skillID uuid.UUID) (matching.JobRequirementV2, bool) {
+	for _, r := range reqs {
+		if r.SkillID == skillID {
+			return r, true
+		}
+	}
+	return matching.JobRequirementV2{}, false
+}
@@ -0,0 +1,177 @@
+package usecase
+
+import (
+	"context"
+	"errors"
+	"testing"
+
+	"skill-sync/internal/domain/matching"
+	"skill-sync/internal/repository"
+
+	"github.com/google/uuid"
+)
+
+type stubEngines struct{ engine matching.Engine }
+
+func (s stubEngines) Engine() matching.Engine { return s.engine }
+
+type stubSeniorityRepo struct {
+	repository.SeniorityRepository
+	user string
+	jobs map[uuid.UUID]string
+}
+
+func (s stubSeniorityRepo) UserExperienceLevel(context.Context, uuid.UUID) (string, error) {
+	return s.user, nil
+}
+
+func (s stubSeniorityRepo) ListJobSeniorities(context.Context, []uuid.UUID) (map[uuid.UUID]string, error) {
+	return s.jobs, nil
+}
+
+func TestSkillGap_GetSkillGaps(t *testing.T) {
+	goID, dockerID, redisID, pgID, mysqlID := uuid.New(), uuid.New(), uuid.New(), uuid.New(), uuid.New()
+	jobA, jobB := uuid.New(), uuid.New()
+	level := func(v int) *int { return &v }
+	flag := func(v bool) *bool { return &v }
+	req := func(id uuid.UUID, name string, mandatory bool) repository.JobSkillRequirementV2 {
+		return repository.JobSkillRequirementV2{SkillID: id, SkillName: name, RequiredLevel: level(3), IsMandatory: flag(mandatory), ImportanceWeight: 1}
+	}
+	held := func(id uuid.UUID, name string) repository.UserSkill {
+		return repository.UserSkill{SkillID: id, SkillName: name, ProficiencyLevel: 3, YearsExperience: 3}
+	}
+	// jobA misses a mandatory skill, jobB only an optional one.
+	twoJobs := stubJobSkillV2Repo{
+		jobA: {req(goID, "Go", true), req(dockerID, "Docker", true)},
+		jobB: {req(goID, "Go", true), req(redisID, "Redis", false)},
+	}
+	sibling := matching.NewSkillGraph([]matching.SkillRelation{{SkillID: pgID, RelatedSkillID: mysqlID, RelationType: matching.RelationSibling, Credit: 0.8}})
+
+	type fixture struct {
+		skills    []repository.UserSkill
+		reqs      stubJobSkillV2Repo
+		graph     *matching.SkillGraph
+		seniority repository.SeniorityRepository
+	}
+	run := func(f fixture, params SkillGapParams) (SkillGapPlan, error) {
+		engine := matching.NewEngine(matching.DefaultV2Profile())
+		engine.Graph = f.graph
+		rows := make([]repository.JobMatchRow, 0, len(f.reqs))
+		for id := range f.reqs {
+			rows = append(rows, repository.JobMatchRow{JobID: id})
+		}
+		uc := NewSkillGapUsecase(
+			stubJobMatchRepo{rows: rows}, nil,
+			recJobRepo{jobs: []repository.Job{{ID: jobA, Title: "Platform Engineer"}, {ID: jobB, Title: "Backend Engineer"}}},
+			f.reqs, stubUserSkillRepo{skills: f.skills}, stubEngines{engine: engine}, f.seniority,
+		)
+		return uc.GetSkillGaps(context.Background(), uuid.New(), params)
+	}
+	byName := func(plan SkillGapPlan) map[string]SkillGapItem {
+		out := map[string]SkillGapItem{}
+		for _, it := range plan.Skills {
+			out[it.SkillName] = it
+		}
+		return out
+	}
+
+	cases := []struct {
+		name   string
+		f      fixture
+		params SkillGapParams
+		err    error
+		check  func(t *testing.T, plan SkillGapPlan)
+	}{
+		{
+			name: "unlocking skills rank first",
+			f:    fixture{skills: []repository.UserSkill{held(goID, "Go")}, reqs: twoJobs},
+			check: func(t *testing.T, plan SkillGapPlan) {
+				if plan.JobsConsidered != 2 || len(plan.Skills) != 2 {
+					t.Fatalf("unexpected plan %+v", plan)
+				}
+				docker, redis := plan.Skills[0], plan.Skills[1]
+				if docker.SkillName != "Docker" || redis.SkillName != "Redis" {
+					t.Fatalf("expected Docker before Redis, got %+v", plan.Skills)
+				}
+				if docker.JobsUnlocked != 1 || docker.MandatoryIn != 1 || !docker.Jobs[0].MandatoryCleared || docker.Jobs[0].Title != "Platform Engineer" {
+					t.Fatalf("expected Docker to clear jobA's mandatory gap, got %+v", docker)
+				}
+				if redis.MandatoryIn != 0 || redis.TotalPoints <= 0 || redis.Jobs[0].JobID != jobB {
+					t.Fatalf("expected Redis to add points to jobB, got %+v", redis)
+				}
+			},
+		},
+		{
+			name:   "limit keeps the top skills",
+			f:      fixture{skills: []repository.UserSkill{held(goID, "Go")}, reqs: twoJobs},
+			params: SkillGapParams{Limit: 1},
+			check: func(t *testing.T, plan SkillGapPlan) {
+				if len(plan.Skills) != 1 || plan.Skills[0].SkillName != "Docker" {
+					t.Fatalf("expected Docker only, got %+v", plan.Skills)
+				}
+			},
+		},
+		{
+			name: "related skill shrinks the gap it credits",
+			f: fixture{
+				skills: []repository.UserSkill{held(goID, "Go"), held(mysqlID, "MySQL")},
+				reqs:   stubJobSkillV2Repo{jobA: {req(goID, "Go", true), req(pgID, "PostgreSQL", true), req(dockerID, "Docker", true)}},
+				graph:  sibling,
+			},
+			check: func(t *testing.T, plan SkillGapPlan) {
+				items := byName(plan)
+				pg, docker := items["PostgreSQL"], items["Docker"]
+				if pg.TotalPoints <= 0 || pg.TotalPoints >= docker.TotalPoints {
+					t.Fatalf("expected MySQL to cover part of PostgreSQL (%d) but none of Docker (%d)", pg.TotalPoints, docker.TotalPoints)
+				}
+			},
+		},
+		{
+			name: "seniority penalty lowers current and simulated scores alike",
+			f: fixture{
+				skills:    []repository.UserSkill{held(goID, "Go")},
+				reqs:      stubJobSkillV2Repo{jobA: {req(goID, "Go", true), req(dockerID, "Docker", true)}},
+				seniority: stubSeniorityRepo{user: "junior", jobs: map[uuid.UUID]string{jobA: "lead"}},
+			},
+			check: func(t *testing.T, plan SkillGapPlan) {
+				base, err := run(fixture{skills: []repository.UserSkill{held(goID, "Go")}, reqs: stubJobSkillV2Repo{jobA: {req(goID, "Go", true), req(dockerID, "Docker", true)}}}, SkillGapParams{})
+				if err != nil {
+					t.Fatalf("unexpected err: %v", err)
+				}
+				got, want := plan.Skills[0].Jobs[0], base.Skills[0].Jobs[0]
+				penalty := int(3 * matching.DefaultV2Profile().SeniorityPenalty)
+				if got.CurrentScore != want.CurrentScore-penalty || got.SimulatedScore != want.SimulatedScore-penalty {
+					t.Fatalf("expected both scores %d points lower, got %+v against %+v", penalty, got, want)
+				}
+				if plan.Skills[0].TotalPoints != base.Skills[0].TotalPoints {
+					t.Fatalf("expected the gap unaffected by the penalty, got %d against %d", plan.Skills[0].TotalPoints, base.Skills[0].TotalPoints)
+				}
+			},
+		},
+		{
+			name: "no skills",
+			f:    fixture{reqs: twoJobs},
+			err:  ErrUserSkillProfileEmpty,
+		},
+		{
+			name: "no candidate jobs",
+			f:    fixture{skills: []repository.UserSkill{held(goID, "Go")}, reqs: stubJobSkillV2Repo{}},
+			err:  ErrNoJobsFound,
+		},
+	}
+	for _, tc := range cases {
+		t.Run(tc.name, func(t *testing.T) {
+			plan, err := run(tc.f, tc.params)
+			if tc.err != nil {
+				if !errors.Is(err, tc.err) {
+					t.Fatalf("err = %v, want %v", err, tc.err)
+				}
+				return
+			}
+			if err != nil {
+				t.Fatalf("unexpected err: %v", err)
+			}
+			tc.check(t, plan)
+		})
+	}
+}
@@ -0,0 +1,154 @@
+package usecase
+
+import (
+	"context"
+	"errors"
+	"log"
+	"strings"
+	"sync/atomic"
+
+	"skill-sync/internal/domain/matching"
+	"skill-sync/internal/repository"
+
+	"github.com/google/uuid"
+)
+
+var ErrSkillRelationNotFound = errors.New("skill relation not found")
+
+const defaultRelationCredit = 0.5
+
+type SkillRelationInput struct {
+	SkillID        uuid.UUID
+	RelatedSkillID uuid.UUID
+	RelationType   string
+	Credit         float64
+}
+
+type SkillRelationUsecase interface {
+	ListRelations(ctx context.Context) ([]repository.SkillRelation, error)
+	UpsertRelation(ctx context.Context, in SkillRelationInput) (repository.SkillRelation, error)
+	DeleteRelation(ctx context.Context, id uuid.UUID) error
+}
+
+type allUsersDirtyMarker interface {
+	MarkAllUsers(ctx context.Context) error
+}
+
+type SkillRelations struct {
+	repo   repository.SkillRelationRepository
+	dirty  allUsersDirtyMarker
+	graph  atomic.Pointer[matching.SkillGraph]
+	logger *log.Logger
+}
+
+func NewSkillRelationUsecase(repo repository.SkillRelationRepository, dirty allUsersDirtyMarker, logger *log.Logger) *SkillRelations {
+	u := &SkillRelations{repo: repo, dirty: dirty, logger: logger}
+	u.graph.Store(matching.NewSkillGraph(nil))
+	return u
+}
+
+// Graph returns the current closure snapshot; it is swapped atomically on reload.
+func (u *SkillRelations) Graph() *matching.SkillGraph {
+	if u == nil {
+		return nil
+	}
+	return u.graph.Load()
+}
+
+func (u *SkillRelations) Reload(ctx context.Context) error {
+	if u == nil || u.repo == nil {
+		return nil
+	}
+	items, err := u.repo.ListAll(ctx)
+	if err != nil {
+		if u.logger != nil {
+			u.logger.Printf("[Matching] Skill graph reload failed: %v", err)
+		}
+		return err
+	}
+
+	edges := make([]matching.SkillRelation, 0, len(items))
+	for _, it := range items {
+		edges = append(edges, matching.SkillRelation{
+			SkillID:        it.SkillID,
+			RelatedSkillID: it.RelatedSkillID,
+			RelationType:   it.RelationType,
+			Credit:         it.Credit,
+		})
+	}
+	g := matching.NewSkillGraph(edges)
+	u.graph.Store(g)
+	if u.logger != nil {
+		u.logger.Printf("[Matching] Skill graph loaded relations=%d skills=%d", len(edges), g.Size())
+	}
+	return nil
+}
+
+func (u *SkillRelations) ListRelations(ctx context.Context) ([]repository.SkillRelation, error) {
+	items, err := u.repo.ListAll(ctx)
+	if err != nil {
+		return nil, ErrInternal
+	}
+	return items, nil
+}
+
+func (u *SkillRelations) UpsertRelation(ctx context.Context, in SkillRelationInput) (repository.SkillRelation, error) {
+	in.RelationType = strings.ToLower(strings.TrimSpace(in.RelationType))
+	if in.SkillID == uuid.Nil || in.RelatedSkillID == uuid.Nil || in.SkillID == in.RelatedSkillID {
+		return repository.SkillRelation{}, ErrInvalidInput
+	}
+	if !matching.IsValidRelationType(in.RelationType) {
+		return repository.SkillRelation{}, ErrInvalidInput
+	}
+	if in.Credit == 0 {
+		in.Credit = defaultRelationCredit
+	}
+	if in.Credit < 0 || in.Credit > 1 {
+		return repository.SkillRelation{}, ErrInvalidInput
+	}
+
+	out, err := u.repo.Upsert(ctx, repository.SkillRelationUpsert{
+		SkillID:        in.SkillID,
+		RelatedSkillID: in.RelatedSkillID,
+		RelationType:   in.RelationType,
+		Credit:         in.Credit,
+	})
+	if err != nil {
+		if isForeignKeyViolation(err) {
+			return repository.SkillRelation{}, ErrSkillNotFound
+		}
+		return repository.SkillRelation{}, ErrInternal
+	}
+
+	u.graphChanged(ctx)
+	return out, nil
+}
+
+func (u *SkillRelations) DeleteRelation(ctx context.Context, id uuid.UUID) error {
+	if id == uuid.Nil {
+		return ErrInvalidInput
+	}
+	deleted, err := u.repo.Delete(ctx, id)
+	if err != nil {
+		return ErrInternal
+	}
+	if !deleted {
+		return ErrSkillRelationNotFound
+	}
+
+	u.graphChanged(ctx)
+	return nil
+}
+
+// graphChanged reloads the graph and marks every user dirty: partial credit
+// can move any stored match, and stored matches are only served for users
+// without pending changes.
+func (u *SkillRelations) graphChanged(ctx context.Context) {
+	_ = u.Reload(ctx)
+	if u.dirty == nil {
+		return
+	}
+	if err := u.dirty.MarkAllUsers(ctx); err != nil && u.logger != nil {
+		u.logger.Printf("[Matching] Marking users dirty after skill graph change failed: %v", err)
+	}
+}
@@ -0,0 +1,179 @@
+package usecase
+
+import (
+	"context"
+	"errors"
+	"strings"
+	"time"
+
+	"skill-sync/internal/repository"
+
+	"github.com/google/uuid"
+)
+
+var ErrFeedbackNotFound = errors.New("feedback not found")
+
+const (
+	DismissNotInterested  = "not_interested"
+	DismissWrongLocation  = "wrong_location"
+	DismissWrongSeniority = "wrong_seniority"
+	DismissWrongRole      = "wrong_role"
+	DismissSalaryTooLow   = "salary_too_low"
+	DismissAlreadyApplied = "already_applied"
+	DismissOther          = "other"
+
+	maxDismissNoteLength  = 500
+	maxCompanyNameLength  = 200
+	defaultReasonStatDays = 30
+)
+
+type DismissJobInput struct {
+	Reason string
+	Note   string
+}
+
+type UserFeedbackUsecase interface {
+	DismissJob(ctx context.Context, userID, jobID uuid.UUID, in DismissJobInput) error
+	UndismissJob(ctx context.Context, userID, jobID uuid.UUID) error
+	ListDismissedJobs(ctx context.Context, userID uuid.UUID) ([]repository.DismissedJob, error)
+	BlockCompany(ctx context.Context, userID uuid.UUID, companyName string) (repository.BlockedCompany, error)
+	UnblockCompany(ctx context.Context, userID, id uuid.UUID) error
+	ListBlockedCompanies(ctx context.Context, userID uuid.UUID) ([]repository.BlockedCompany, error)
+	DismissalReasonStats(ctx context.Context, days int) ([]repository.DismissalReasonCount, error)
+}
+
+// ExclusionProvider is consulted by every surface that shows jobs to a user.
+type ExclusionProvider interface {
+	ListExclusions(ctx context.Context, userID uuid.UUID) (repository.UserExclusions, error)
+}
+
+type UserFeedback struct {
+	repo repository.UserFeedbackRepository
+}
+
+func NewUserFeedbackUsecase(repo repository.UserFeedbackRepository) *UserFeedback {
+	return &UserFeedback{repo: repo}
+}
+
+func IsValidDismissReason(reason string) bool {
+	switch reason {
+	case DismissNotInterested, DismissWrongLocation, DismissWrongSeniority, DismissWrongRole,
+		DismissSalaryTooLow, DismissAlreadyApplied, DismissOther:
+		return true
+	default:
+		return false
+	}
+}
+
+func (u *UserFeedback) DismissJob(ctx context.Context, userID, jobID uuid.UUID, in DismissJobInput) error {
+	if userID == uuid.Nil {
+		return ErrUnauthorized
+	}
+	if jobID == uuid.Nil {
+		return ErrJobNotFound
+	}
+	reason := strings.ToLower(strings.TrimSpace(in.Reason))
+	if reason == "" {
+		reason = DismissNotInterested
+	}
+	if !IsValidDismissReason(reason) {
+		return ErrInvalidInput
+	}
+	note := strings.TrimSpace(in.Note)
+	if len(note) > maxDismissNoteLength {
+		return ErrInvalidInput
+	}
+
+	if err := u.repo.DismissJob(ctx, userID, jobID, reason, note); err != nil {
+		if isForeignKeyViolation(err) {
+			return ErrJobNotFound
+		}
+		return ErrInternal
+	}
+	return nil
+}
+
+func (u *UserFeedback) UndismissJob(ctx context.Context, userID, jobID uuid.UUID) error {
+	if userID == uuid.Nil {
+		return ErrUnauthorized
+	}
+	ok, err := u.repo.UndismissJob(ctx, userID, jobID)
+	if err != nil {
+		return ErrInternal
+	}
+	if !ok {
+		return ErrFeedbackNotFound
+	}
+	return nil
+}
+
+func (u *UserFeedback) ListDismissedJobs(ctx context.Context, userID uuid.UUID) ([]repository.DismissedJob, error) {
+	if userID == uuid.Nil {
+		return nil, ErrUnauthorized
+	}
+	items, err := u.repo.ListDismissedJobs(ctx, userID)
+	if err != nil {
+		return nil, ErrInternal
+	}
+	return items, nil
+}
+
+func (u *UserFeedback) BlockCompany(ctx context.Context, userID uuid.UUID, companyName string) (repository.BlockedCompany, error) {
+	if userID == uuid.Nil {
+		return repository.BlockedCompany{}, ErrUnauthorized
+	}
+	companyName = strings.Join(strings.Fields(companyName), " ")
+	if companyName == "" || len(companyName) > maxCompanyNameLength {
+		return repository.BlockedCompany{}, ErrInvalidInput
+	}
+
+	out, err := u.repo.BlockCompany(ctx, userID, companyName)
+	if err != nil {
+		return repository.BlockedCompany{}, ErrInternal
+	}
+	return out, nil
+}
+
+func (u *UserFeedback) UnblockCompany(ctx context.Context, userID, id uuid.UUID) error {
+	if userID == uuid.Nil {
+		return ErrUnauthorized
+	}
+	ok, err := u.repo.UnblockCompany(ctx, userID, id)
+	if err != nil {
+		return ErrInternal
+	}
+	if !ok {
+		return ErrFeedbackNotFound
+	}
+	return nil
+}
+
+func (u *UserFeedback) ListBlockedCompanies(ctx context.Context, userID uuid.UUID) ([]repository.BlockedCompany, error) {
+	if userID == uuid.Nil {
+		return nil, ErrUnauthorized
+	}
+	items, err := u.repo.ListBlockedCompanies(ctx, userID)
+	if err != nil {
+		return nil, ErrInternal
+	}
+	return items, nil
+}
+
+func (u *UserFeedback) DismissalReasonStats(ctx context.Context, days int) ([]repository.DismissalReasonCount, error) {
+	if days <= 0 {
+		days = defaultReasonStatDays
+	}
+	since := time.Now().UTC().AddDate(0, 0, -days)
+	items, err := u.repo.CountDismissalReasons(ctx, since)
+	if err != nil {
+		return nil, ErrInternal
+	}
+	return items, nil
+}
+
+func (u *UserFeedback) ListExclusions(ctx context.Context, userID uuid.UUID) (repository.UserExclusions, error) {
+	if u == nil || u.repo == nil || userID == uuid.Nil {
+		return repository.UserExclusions{}, nil
+	}
+	return u.repo.ListExclusions(ctx, userID)
+}
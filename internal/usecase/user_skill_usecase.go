@@ -45,12 +45,17 @@ type UserSkillUsecase interface {
 	RemoveUserSkill(ctx context.Context, userID uuid.UUID, skillID uuid.UUID) error
 }
 
+type MatchDirtyMarker interface {
+	MarkUsers(ctx context.Context, userIDs ...uuid.UUID) error
+}
+
 type UserSkill struct {
-	repo repository.UserSkillRepository
+	repo  repository.UserSkillRepository
+	dirty MatchDirtyMarker
 }
 
-func NewUserSkillUsecase(repo repository.UserSkillRepository) *UserSkill {
-	return &UserSkill{repo: repo}
+func NewUserSkillUsecase(repo repository.UserSkillRepository, dirty MatchDirtyMarker) *UserSkill {
+	return &UserSkill{repo: repo, dirty: dirty}
 }
 
 func (u *UserSkill) ListUserSkills(ctx context.Context, userID uuid.UUID) ([]UserSkillItem, error) {
@@ -114,6 +119,7 @@ func (u *UserSkill) AddUserSkill(ctx context.Context, userID uuid.UUID, in AddUs
 		}
 		return UserSkillItem{}, ErrInternal
 	}
+	u.markDirty(ctx, userID)
 
 	return UserSkillItem{
 		ID:               created.ID,
@@ -147,6 +153,8 @@ func (u *UserSkill) UpdateUserSkill(ctx context.Context, userID uuid.UUID, skill
 		}
 		return UserSkillItem{}, ErrInternal
 	}
+	u.markDirty(ctx, userID)
+
 	return UserSkillItem{
 		ID:               updated.ID,
 		SkillID:          updated.SkillID,
@@ -171,6 +179,7 @@ func (u *UserSkill) DeleteUserSkill(ctx context.Context, userID uuid.UUID, skill
 			return ErrInternal
 		}
 	}
+	u.markDirty(ctx, userID)
 	return nil
 }
 
@@ -188,9 +197,19 @@ func (u *UserSkill) RemoveUserSkill(ctx context.Context, userID uuid.UUID, skill
 			return ErrInternal
 		}
 	}
+	u.markDirty(ctx, userID)
 	return nil
 }
 
+// markDirty is best effort: a lost mark only delays re-matching until the
+// next full rebuild.
+func (u *UserSkill) markDirty(ctx context.Context, userID uuid.UUID) {
+	if u.dirty == nil || userID == uuid.Nil {
+		return
+	}
+	_ = u.dirty.MarkUsers(ctx, userID)
+}
+
 func isValidProficiency(v int) bool {
 	return v >= 1 && v <= 5
 }
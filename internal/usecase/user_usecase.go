@@ -15,11 +15,12 @@ type UserUsecase interface {
 }
 
 type User struct {
-	svc *ucuser.Service
+	svc   *ucuser.Service
+	dirty MatchDirtyMarker
 }
 
-func NewUserUsecase(users user.Repository) *User {
-	return &User{svc: ucuser.NewService(users)}
+func NewUserUsecase(users user.Repository, dirty MatchDirtyMarker) *User {
+	return &User{svc: ucuser.NewService(users), dirty: dirty}
 }
 
 func (u *User) GetProfile(ctx context.Context, userID uuid.UUID) (ucuser.Profile, error) {
@@ -27,5 +28,13 @@ func (u *User) GetProfile(ctx context.Context, userID uuid.UUID) (ucuser.Profile
 }
 
 func (u *User) UpdateProfile(ctx context.Context, userID uuid.UUID, in ucuser.UpdateProfileInput) (ucuser.Profile, error) {
-	return u.svc.UpdateProfile(ctx, userID, in)
+	p, err := u.svc.UpdateProfile(ctx, userID, in)
+	if err != nil {
+		return p, err
+	}
+	// The experience level feeds the seniority factor of stored matches.
+	if in.ExperienceLevel != nil && u.dirty != nil {
+		_ = u.dirty.MarkUsers(ctx, userID)
+	}
+	return p, nil
 }
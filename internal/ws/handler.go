@@ -5,6 +5,8 @@ import (
 	"net/http"
 	"strings"
 
+	"skill-sync/internal/pkg/jwt"
+
 	"github.com/gofiber/fiber/v3"
 	"github.com/gofiber/fiber/v3/middleware/adaptor"
 	"github.com/gorilla/websocket"
@@ -12,11 +14,12 @@ import (
 
 type Handler struct {
 	hub    *Hub
+	jwt    jwt.Service
 	logger *log.Logger
 }
 
-func NewHandler(hub *Hub, logger *log.Logger) *Handler {
-	return &Handler{hub: hub, logger: logger}
+func NewHandler(hub *Hub, jwtSvc jwt.Service, logger *log.Logger) *Handler {
+	return &Handler{hub: hub, jwt: jwtSvc, logger: logger}
 }
 
 var upgrader = websocket.Upgrader{
@@ -39,7 +42,37 @@ func (h *Handler) HandleJobsWS(c fiber.Ctx) error {
 	if len(keyword) > 100 {
 		return fiber.NewError(fiber.StatusBadRequest, "keyword is too long")
 	}
+	if strings.HasPrefix(keyword, userChannelPrefix) {
+		return fiber.NewError(fiber.StatusBadRequest, "keyword is reserved")
+	}
+
+	return h.upgrade(c, keyword)
+}
+
+// HandleUserWS subscribes to the caller's private channel. Browsers cannot
+// set headers on a websocket handshake, so the access token may also be
+// passed as ?token=.
+func (h *Handler) HandleUserWS(c fiber.Ctx) error {
+	if h == nil || h.hub == nil || h.jwt == nil {
+		return fiber.ErrServiceUnavailable
+	}
+
+	token := strings.TrimSpace(c.Query("token"))
+	if auth := strings.TrimSpace(c.Get("Authorization")); token == "" && len(auth) > 7 && strings.EqualFold(auth[:7], "Bearer ") {
+		token = strings.TrimSpace(auth[7:])
+	}
+	if token == "" {
+		return fiber.NewError(fiber.StatusUnauthorized, "Unauthorized")
+	}
+	claims, err := h.jwt.ValidateToken(token)
+	if err != nil || claims.TokenType != jwt.TokenTypeAccess || h.jwt.IsRefreshToken(claims) {
+		return fiber.NewError(fiber.StatusUnauthorized, "Invalid token")
+	}
+
+	return h.upgrade(c, UserChannel(claims.UserID))
+}
 
+func (h *Handler) upgrade(c fiber.Ctx, keyword string) error {
 	fiberHandler := adaptor.HTTPHandlerFunc(func(w http.ResponseWriter, r *http.Request) {
 		conn, err := upgrader.Upgrade(w, r, nil)
 		if err != nil {
@@ -5,8 +5,14 @@ import (
 	"strings"
 	"sync/atomic"
 	"time"
+
+	"github.com/google/uuid"
 )
 
+// userChannelPrefix scopes hub keywords that belong to a single user. Public
+// keyword subscriptions may not use it.
+const userChannelPrefix = "user:"
+
 type JobsUpdatedEvent struct {
 	Type      string `json:"type"`
 	Keyword   string `json:"keyword"`
@@ -14,6 +20,21 @@ type JobsUpdatedEvent struct {
 	Timestamp string `json:"timestamp"`
 }
 
+type SavedSearchMatchEvent struct {
+	Type          string           `json:"type"`
+	SavedSearchID string           `json:"saved_search_id"`
+	Name          string           `json:"name"`
+	Jobs          []SavedSearchJob `json:"jobs"`
+	Timestamp     string           `json:"timestamp"`
+}
+
+type SavedSearchJob struct {
+	JobID       string `json:"job_id"`
+	Title       string `json:"title"`
+	CompanyName string `json:"company_name"`
+	Location    string `json:"location"`
+}
+
 var defaultHub atomic.Pointer[Hub]
 
 func SetDefaultHub(h *Hub) {
@@ -44,3 +65,35 @@ func NotifyJobsUpdated(keyword string, source string) {
 
 	h.Broadcast(keyword, b)
 }
+
+func UserChannel(userID uuid.UUID) string {
+	return userChannelPrefix + userID.String()
+}
+
+func NotifySavedSearchMatches(userID, searchID uuid.UUID, name string, jobs []SavedSearchJob) {
+	h := defaultHub.Load()
+	if h == nil || userID == uuid.Nil || len(jobs) == 0 {
+		return
+	}
+
+	evt := SavedSearchMatchEvent{
+		Type:          "saved_search_match",
+		SavedSearchID: searchID.String(),
+		Name:          name,
+		Jobs:          jobs,
+		Timestamp:     time.Now().UTC().Format(time.RFC3339),
+	}
+	b, err := json.Marshal(evt)
+	if err != nil {
+		return
+	}
+
+	h.Broadcast(UserChannel(userID), b)
+}
+
+// Notifier exposes the package-level notifications as a dependency.
+type Notifier struct{}
+
+func (Notifier) NotifySavedSearchMatches(userID, searchID uuid.UUID, name string, jobs []SavedSearchJob) {
+	NotifySavedSearchMatches(userID, searchID, name, jobs)
+}
@@ -0,0 +1,13 @@
+BEGIN;
+
+CREATE TABLE IF NOT EXISTS match_dirty_users (
+  user_id UUID PRIMARY KEY REFERENCES users(id) ON DELETE CASCADE,
+  marked_at TIMESTAMPTZ NOT NULL DEFAULT now()
+);
+
+CREATE TABLE IF NOT EXISTS match_dirty_jobs (
+  job_id UUID PRIMARY KEY REFERENCES jobs(id) ON DELETE CASCADE,
+  marked_at TIMESTAMPTZ NOT NULL DEFAULT now()
+);
+
+COMMIT;
@@ -0,0 +1,44 @@
+BEGIN;
+
+ALTER TABLE jobs
+  ADD COLUMN IF NOT EXISTS seniority TEXT;
+
+DO $$
+BEGIN
+  IF NOT EXISTS (
+    SELECT 1
+    FROM pg_constraint
+    WHERE conname = 'chk_jobs_seniority'
+  ) THEN
+    ALTER TABLE jobs
+      ADD CONSTRAINT chk_jobs_seniority
+      CHECK (seniority IS NULL OR seniority IN ('intern', 'junior', 'mid', 'senior', 'lead'));
+  END IF;
+END $$;
+
+CREATE INDEX IF NOT EXISTS idx_jobs_seniority
+  ON jobs(seniority);
+
+ALTER TABLE scoring_profiles
+  ADD COLUMN IF NOT EXISTS seniority_penalty NUMERIC(5,2) NOT NULL DEFAULT 0;
+
+DO $$
+BEGIN
+  IF NOT EXISTS (
+    SELECT 1
+    FROM pg_constraint
+    WHERE conname = 'chk_scoring_profiles_seniority_penalty'
+  ) THEN
+    ALTER TABLE scoring_profiles
+      ADD CONSTRAINT chk_scoring_profiles_seniority_penalty
+      CHECK (seniority_penalty >= 0 AND seniority_penalty <= 25);
+  END IF;
+END $$;
+
+UPDATE scoring_profiles SET is_default = false WHERE name = 'default_v2' AND version < 3;
+
+INSERT INTO scoring_profiles (name, version, mandatory_weight, optional_weight, experience_weight, importance_mode, partial_credit_curve, years_cap, related_credit, seniority_penalty, is_default)
+VALUES ('default_v2', 3, 60, 30, 10, 'flat', 'linear', 1, 1, 5, true)
+ON CONFLICT (name, version) DO NOTHING;
+
+COMMIT;
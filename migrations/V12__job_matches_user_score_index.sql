@@ -0,0 +1,6 @@
+BEGIN;
+
+CREATE INDEX IF NOT EXISTS idx_job_matches_user_score
+  ON job_matches(user_id, match_score DESC);
+
+COMMIT;
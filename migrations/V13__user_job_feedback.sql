@@ -0,0 +1,27 @@
+BEGIN;
+
+CREATE TABLE IF NOT EXISTS user_dismissed_jobs (
+  user_id UUID NOT NULL REFERENCES users(id) ON DELETE CASCADE,
+  job_id UUID NOT NULL REFERENCES jobs(id) ON DELETE CASCADE,
+  reason TEXT NOT NULL DEFAULT 'not_interested',
+  note TEXT,
+  created_at TIMESTAMPTZ NOT NULL DEFAULT now(),
+  PRIMARY KEY (user_id, job_id),
+  CONSTRAINT chk_user_dismissed_jobs_reason CHECK (reason IN (
+    'not_interested', 'wrong_location', 'wrong_seniority', 'wrong_role', 'salary_too_low', 'already_applied', 'other'
+  ))
+);
+
+CREATE INDEX IF NOT EXISTS idx_user_dismissed_jobs_reason
+  ON user_dismissed_jobs(reason);
+
+CREATE TABLE IF NOT EXISTS user_blocked_companies (
+  id UUID PRIMARY KEY DEFAULT gen_random_uuid(),
+  user_id UUID NOT NULL REFERENCES users(id) ON DELETE CASCADE,
+  company_name TEXT NOT NULL,
+  company_key TEXT NOT NULL,
+  created_at TIMESTAMPTZ NOT NULL DEFAULT now(),
+  CONSTRAINT uq_user_blocked_companies UNIQUE (user_id, company_key)
+);
+
+COMMIT;
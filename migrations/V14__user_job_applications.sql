@@ -0,0 +1,40 @@
+BEGIN;
+
+CREATE TABLE IF NOT EXISTS user_job_applications (
+  id UUID PRIMARY KEY DEFAULT gen_random_uuid(),
+  user_id UUID NOT NULL REFERENCES users(id) ON DELETE CASCADE,
+  job_id UUID NOT NULL REFERENCES jobs(id) ON DELETE CASCADE,
+  stage TEXT NOT NULL DEFAULT 'saved',
+  notes TEXT,
+  contact_name TEXT,
+  contact_email TEXT,
+  contact_phone TEXT,
+  interview_at TIMESTAMPTZ,
+  saved_match_score SMALLINT,
+  applied_at TIMESTAMPTZ,
+  stage_changed_at TIMESTAMPTZ NOT NULL DEFAULT now(),
+  created_at TIMESTAMPTZ NOT NULL DEFAULT now(),
+  updated_at TIMESTAMPTZ NOT NULL DEFAULT now(),
+  CONSTRAINT uq_user_job_applications UNIQUE (user_id, job_id),
+  CONSTRAINT chk_user_job_applications_stage CHECK (stage IN (
+    'saved', 'applied', 'screening', 'interview', 'offer', 'rejected', 'withdrawn'
+  )),
+  CONSTRAINT chk_user_job_applications_score CHECK (saved_match_score IS NULL OR saved_match_score BETWEEN 0 AND 100)
+);
+
+CREATE INDEX IF NOT EXISTS idx_user_job_applications_user_stage
+  ON user_job_applications(user_id, stage, updated_at DESC);
+
+CREATE TABLE IF NOT EXISTS user_job_application_events (
+  id UUID PRIMARY KEY DEFAULT gen_random_uuid(),
+  application_id UUID NOT NULL REFERENCES user_job_applications(id) ON DELETE CASCADE,
+  from_stage TEXT,
+  to_stage TEXT NOT NULL,
+  note TEXT,
+  created_at TIMESTAMPTZ NOT NULL DEFAULT now()
+);
+
+CREATE INDEX IF NOT EXISTS idx_user_job_application_events_app
+  ON user_job_application_events(application_id, created_at);
+
+COMMIT;
@@ -0,0 +1,45 @@
+BEGIN;
+
+CREATE TABLE IF NOT EXISTS saved_searches (
+  id UUID PRIMARY KEY DEFAULT gen_random_uuid(),
+  user_id UUID NOT NULL REFERENCES users(id) ON DELETE CASCADE,
+  name TEXT NOT NULL,
+  title TEXT NOT NULL DEFAULT '',
+  company_name TEXT NOT NULL DEFAULT '',
+  location TEXT NOT NULL DEFAULT '',
+  skills TEXT[] NOT NULL DEFAULT '{}',
+  seniority TEXT[] NOT NULL DEFAULT '{}',
+  notify TEXT NOT NULL DEFAULT 'instant',
+  last_matched_at TIMESTAMPTZ,
+  created_at TIMESTAMPTZ NOT NULL DEFAULT now(),
+  updated_at TIMESTAMPTZ NOT NULL DEFAULT now(),
+  CONSTRAINT chk_saved_searches_notify CHECK (notify IN ('instant', 'none'))
+);
+
+CREATE INDEX IF NOT EXISTS idx_saved_searches_user
+  ON saved_searches(user_id, created_at DESC);
+
+CREATE TABLE IF NOT EXISTS saved_search_matches (
+  saved_search_id UUID NOT NULL REFERENCES saved_searches(id) ON DELETE CASCADE,
+  job_id UUID NOT NULL REFERENCES jobs(id) ON DELETE CASCADE,
+  matched_at TIMESTAMPTZ NOT NULL DEFAULT now(),
+  seen_at TIMESTAMPTZ,
+  PRIMARY KEY (saved_search_id, job_id)
+);
+
+CREATE INDEX IF NOT EXISTS idx_saved_search_matches_recent
+  ON saved_search_matches(saved_search_id, matched_at DESC);
+
+-- Single-row cursor over jobs.created_at; alerts only look at jobs past it.
+CREATE TABLE IF NOT EXISTS saved_search_alert_cursor (
+  id SMALLINT PRIMARY KEY DEFAULT 1,
+  last_created_at TIMESTAMPTZ NOT NULL,
+  last_job_id UUID NOT NULL,
+  updated_at TIMESTAMPTZ NOT NULL DEFAULT now(),
+  CONSTRAINT chk_saved_search_alert_cursor_single CHECK (id = 1)
+);
+
+CREATE INDEX IF NOT EXISTS idx_jobs_created_at_id
+  ON jobs(created_at, id);
+
+COMMIT;
@@ -0,0 +1,81 @@
+BEGIN;
+
+-- Append-only: a row is written whenever a recomputed pair's score, score
+-- components, scoring profile or version differ from its last row here;
+-- job_matches keeps only the latest value.
+CREATE TABLE IF NOT EXISTS job_match_history (
+  id BIGSERIAL PRIMARY KEY,
+  user_id UUID NOT NULL,
+  job_id UUID NOT NULL,
+  match_score NUMERIC(5,2) NOT NULL,
+  mandatory_score NUMERIC(6,2),
+  optional_score NUMERIC(6,2),
+  experience_score NUMERIC(6,2),
+  seniority_score NUMERIC(6,2),
+  scoring_profile TEXT,
+  scoring_version INT,
+  recorded_at TIMESTAMPTZ NOT NULL DEFAULT now()
+);
+
+CREATE INDEX IF NOT EXISTS idx_job_match_history_pair
+  ON job_match_history(user_id, job_id, recorded_at DESC);
+
+-- Average of a user's top-N scores, captured after each matching run that
+-- changed it.
+CREATE TABLE IF NOT EXISTS user_match_snapshots (
+  id BIGSERIAL PRIMARY KEY,
+  user_id UUID NOT NULL REFERENCES users(id) ON DELETE CASCADE,
+  top_n SMALLINT NOT NULL,
+  top_avg NUMERIC(5,2) NOT NULL,
+  matched_jobs INT NOT NULL,
+  scoring_profile TEXT,
+  scoring_version INT,
+  recorded_at TIMESTAMPTZ NOT NULL DEFAULT now()
+);
+
+CREATE INDEX IF NOT EXISTS idx_user_match_snapshots_user
+  ON user_match_snapshots(user_id, recorded_at DESC);
+
+-- Written by trigger so every path that edits user_skills is covered. No
+-- foreign keys: rows are inserted while a user's skills cascade-delete.
+CREATE TABLE IF NOT EXISTS user_skill_events (
+  id BIGSERIAL PRIMARY KEY,
+  user_id UUID NOT NULL,
+  skill_id UUID NOT NULL,
+  action TEXT NOT NULL,
+  old_proficiency INT,
+  new_proficiency INT,
+  old_years INT,
+  new_years INT,
+  created_at TIMESTAMPTZ NOT NULL DEFAULT now(),
+  CONSTRAINT chk_user_skill_events_action CHECK (action IN ('added', 'updated', 'removed'))
+);
+
+CREATE INDEX IF NOT EXISTS idx_user_skill_events_user
+  ON user_skill_events(user_id, created_at);
+
+CREATE OR REPLACE FUNCTION log_user_skill_event() RETURNS trigger AS $$
+BEGIN
+  IF TG_OP = 'INSERT' THEN
+    INSERT INTO user_skill_events (user_id, skill_id, action, new_proficiency, new_years)
+    VALUES (NEW.user_id, NEW.skill_id, 'added', NEW.proficiency_level, NEW.years_experience);
+  ELSIF TG_OP = 'UPDATE' THEN
+    IF NEW.proficiency_level IS DISTINCT FROM OLD.proficiency_level
+       OR NEW.years_experience IS DISTINCT FROM OLD.years_experience THEN
+      INSERT INTO user_skill_events (user_id, skill_id, action, old_proficiency, new_proficiency, old_years, new_years)
+      VALUES (NEW.user_id, NEW.skill_id, 'updated', OLD.proficiency_level, NEW.proficiency_level, OLD.years_experience, NEW.years_experience);
+    END IF;
+  ELSE
+    INSERT INTO user_skill_events (user_id, skill_id, action, old_proficiency, old_years)
+    VALUES (OLD.user_id, OLD.skill_id, 'removed', OLD.proficiency_level, OLD.years_experience);
+  END IF;
+  RETURN NULL;
+END;
+$$ LANGUAGE plpgsql;
+
+DROP TRIGGER IF EXISTS trg_user_skill_events ON user_skills;
+CREATE TRIGGER trg_user_skill_events
+  AFTER INSERT OR UPDATE OR DELETE ON user_skills
+  FOR EACH ROW EXECUTE FUNCTION log_user_skill_event();
+
+COMMIT;
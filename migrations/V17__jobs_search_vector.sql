@@ -0,0 +1,93 @@
+BEGIN;
+
+ALTER TABLE jobs ADD COLUMN IF NOT EXISTS search_vector tsvector;
+
+-- The 'simple' configuration is used on both sides because listings mix
+-- Indonesian and English; stemming for either language would mangle the other.
+-- Weights: title A, skill names B, company C, description D.
+CREATE OR REPLACE FUNCTION job_search_document(p_job_id UUID, p_title TEXT, p_company TEXT, p_description TEXT)
+RETURNS tsvector AS $$
+  SELECT
+    setweight(to_tsvector('simple', COALESCE(p_title, '')), 'A') ||
+    setweight(to_tsvector('simple', COALESCE((
+      SELECT string_agg(s.name, ' ')
+      FROM job_skills js
+      JOIN skills s ON s.id = js.skill_id
+      WHERE js.job_id = p_job_id
+    ), '')), 'B') ||
+    setweight(to_tsvector('simple', COALESCE(p_company, '')), 'C') ||
+    setweight(to_tsvector('simple', COALESCE(p_description, '')), 'D');
+$$ LANGUAGE sql STABLE;
+
+CREATE OR REPLACE FUNCTION jobs_search_vector_refresh() RETURNS trigger AS $$
+BEGIN
+  NEW.search_vector := job_search_document(NEW.id, NEW.title, NEW.company, NEW.description);
+  RETURN NEW;
+END;
+$$ LANGUAGE plpgsql;
+
+DROP TRIGGER IF EXISTS trg_jobs_search_vector ON jobs;
+CREATE TRIGGER trg_jobs_search_vector
+  BEFORE INSERT OR UPDATE OF title, company, description ON jobs
+  FOR EACH ROW EXECUTE FUNCTION jobs_search_vector_refresh();
+
+-- Skills are extracted after the job row is written, so skill changes refresh
+-- the vector too. Statement-level so a batch of skills costs one update per job.
+CREATE OR REPLACE FUNCTION job_skills_search_vector_refresh() RETURNS trigger AS $$
+BEGIN
+  UPDATE jobs j
+  SET search_vector = job_search_document(j.id, j.title, j.company, j.description)
+  WHERE j.id IN (SELECT DISTINCT job_id FROM changed_skills);
+  RETURN NULL;
+END;
+$$ LANGUAGE plpgsql;
+
+DROP TRIGGER IF EXISTS trg_job_skills_search_vector_ins ON job_skills;
+CREATE TRIGGER trg_job_skills_search_vector_ins
+  AFTER INSERT ON job_skills
+  REFERENCING NEW TABLE AS changed_skills
+  FOR EACH STATEMENT EXECUTE FUNCTION job_skills_search_vector_refresh();
+
+DROP TRIGGER IF EXISTS trg_job_skills_search_vector_del ON job_skills;
+CREATE TRIGGER trg_job_skills_search_vector_del
+  AFTER DELETE ON job_skills
+  REFERENCING OLD TABLE AS changed_skills
+  FOR EACH STATEMENT EXECUTE FUNCTION job_skills_search_vector_refresh();
+
+DROP TRIGGER IF EXISTS trg_job_skills_search_vector_upd ON job_skills;
+CREATE TRIGGER trg_job_skills_search_vector_upd
+  AFTER UPDATE ON job_skills
+  REFERENCING NEW TABLE AS changed_skills
+  FOR EACH STATEMENT EXECUTE FUNCTION job_skills_search_vector_refresh();
+
+-- Renaming a skill changes the B-weighted text of every job that lists it.
+-- Transition tables cannot be combined with UPDATE OF, so renames are picked
+-- out by comparing the old and new rows.
+CREATE OR REPLACE FUNCTION skills_search_vector_refresh() RETURNS trigger AS $$
+BEGIN
+  UPDATE jobs j
+  SET search_vector = job_search_document(j.id, j.title, j.company, j.description)
+  WHERE j.id IN (
+    SELECT DISTINCT js.job_id
+    FROM job_skills js
+    JOIN new_skills n ON n.id = js.skill_id
+    JOIN old_skills o ON o.id = n.id
+    WHERE o.name IS DISTINCT FROM n.name
+  );
+  RETURN NULL;
+END;
+$$ LANGUAGE plpgsql;
+
+DROP TRIGGER IF EXISTS trg_skills_search_vector ON skills;
+CREATE TRIGGER trg_skills_search_vector
+  AFTER UPDATE ON skills
+  REFERENCING OLD TABLE AS old_skills NEW TABLE AS new_skills
+  FOR EACH STATEMENT EXECUTE FUNCTION skills_search_vector_refresh();
+
+UPDATE jobs
+SET search_vector = job_search_document(id, title, company, description)
+WHERE search_vector IS NULL;
+
+CREATE INDEX IF NOT EXISTS idx_jobs_search_vector ON jobs USING GIN (search_vector);
+
+COMMIT;
@@ -0,0 +1,26 @@
+BEGIN;
+
+CREATE TABLE IF NOT EXISTS search_synonyms (
+  id UUID PRIMARY KEY DEFAULT gen_random_uuid(),
+  language TEXT NOT NULL,
+  term TEXT NOT NULL,
+  synonyms TEXT[] NOT NULL DEFAULT '{}',
+  -- One-way entries expand term into synonyms only; two-way entries make
+  -- every member expand to all the others.
+  two_way BOOLEAN NOT NULL DEFAULT false,
+  created_at TIMESTAMPTZ NOT NULL DEFAULT now(),
+  updated_at TIMESTAMPTZ NOT NULL DEFAULT now(),
+  CONSTRAINT chk_search_synonyms_language CHECK (language IN ('id', 'en')),
+  CONSTRAINT uq_search_synonyms_term UNIQUE (language, term)
+);
+
+-- The dictionary previously hard-coded in search.Synonyms.
+INSERT INTO search_synonyms (language, term, synonyms) VALUES
+  ('id', 'office boy', ARRAY['office', 'helper', 'staff']),
+  ('id', 'admin', ARRAY['administration', 'staff admin']),
+  ('en', 'frontend', ARRAY['front end', 'frontend developer', 'ui developer']),
+  ('en', 'backend', ARRAY['back end', 'server developer']),
+  ('en', 'designer', ARRAY['graphic designer', 'ui designer', 'visual designer'])
+ON CONFLICT (language, term) DO NOTHING;
+
+COMMIT;
@@ -0,0 +1,10 @@
+BEGIN;
+
+CREATE EXTENSION IF NOT EXISTS pg_trgm;
+
+-- Fuzzy title fallback for /jobs: ListJobsForListing filters with
+-- `query <% lower(title)` (word similarity), which this index serves.
+CREATE INDEX IF NOT EXISTS idx_jobs_title_trgm
+  ON jobs USING GIN (lower(title) gin_trgm_ops);
+
+COMMIT;
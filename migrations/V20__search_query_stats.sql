@@ -0,0 +1,14 @@
+BEGIN;
+
+-- How often each normalized /jobs title query was searched (first pages that
+-- returned results only); feeds autocomplete ranking.
+CREATE TABLE IF NOT EXISTS search_query_stats (
+  query TEXT PRIMARY KEY,
+  search_count BIGINT NOT NULL DEFAULT 0,
+  last_searched_at TIMESTAMPTZ NOT NULL DEFAULT now()
+);
+
+CREATE INDEX IF NOT EXISTS idx_search_query_stats_count
+  ON search_query_stats(search_count DESC);
+
+COMMIT;
@@ -0,0 +1,8 @@
+BEGIN;
+
+-- Unranked /jobs pages are ordered and keyset-paginated on
+-- (COALESCE(posted_at, created_at), id), both descending.
+CREATE INDEX IF NOT EXISTS idx_jobs_listing_order
+  ON jobs ((COALESCE(posted_at, created_at)) DESC, id DESC);
+
+COMMIT;
@@ -0,0 +1,25 @@
+BEGIN;
+
+-- Per-source weight for /jobs ranking (SourceQuality in the score
+-- breakdown). 1 is neutral; jobs whose source has no row get 1 as well.
+ALTER TABLE job_sources
+  ADD COLUMN IF NOT EXISTS ranking_weight NUMERIC(4,2) NOT NULL DEFAULT 1
+    CHECK (ranking_weight > 0 AND ranking_weight <= 10);
+
+-- Company career pages are registered by the scraper on first run.
+INSERT INTO job_sources (id, name)
+VALUES (gen_random_uuid(), 'Company Careers')
+ON CONFLICT (name) DO NOTHING;
+
+UPDATE job_sources SET ranking_weight = CASE name
+    WHEN 'Company Careers' THEN 4
+    WHEN 'JobStreet' THEN 3
+    WHEN 'Glints' THEN 3
+    WHEN 'Kalibrr' THEN 3
+    WHEN 'LinkedIn' THEN 3
+    WHEN 'Indeed' THEN 3
+    WHEN 'Dev.to Jobs' THEN 2
+    ELSE ranking_weight
+  END;
+
+COMMIT;
@@ -0,0 +1,13 @@
+BEGIN;
+
+-- Canonical gazetteer ID of jobs.location, a slash-separated path such as
+-- 'dki-jakarta/jakarta-selatan'. NULL when the location was not recognised.
+-- text_pattern_ops serves both the exact match and the "id/%" prefix match
+-- used for hierarchical location filters.
+ALTER TABLE jobs
+  ADD COLUMN IF NOT EXISTS location_id TEXT;
+
+CREATE INDEX IF NOT EXISTS idx_jobs_location_id
+  ON jobs (location_id text_pattern_ops);
+
+COMMIT;
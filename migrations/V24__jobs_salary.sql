@@ -0,0 +1,31 @@
+BEGIN;
+
+-- Salary parsed from the posting, normalised to IDR per month so postings in
+-- other currencies and periods compare directly. salary_currency and
+-- salary_period record what the posting stated. All NULL when no salary was
+-- found; a single figure sets salary_min = salary_max.
+ALTER TABLE jobs
+  ADD COLUMN IF NOT EXISTS salary_min BIGINT,
+  ADD COLUMN IF NOT EXISTS salary_max BIGINT,
+  ADD COLUMN IF NOT EXISTS salary_currency TEXT,
+  ADD COLUMN IF NOT EXISTS salary_period TEXT;
+
+DO $$
+BEGIN
+  IF NOT EXISTS (
+    SELECT 1
+    FROM pg_constraint
+    WHERE conname = 'chk_jobs_salary_period'
+  ) THEN
+    ALTER TABLE jobs
+      ADD CONSTRAINT chk_jobs_salary_period
+      CHECK (salary_period IS NULL OR salary_period IN ('hour', 'day', 'week', 'month', 'year'));
+  END IF;
+END $$;
+
+-- /jobs?sort=salary orders and keyset-paginates on the top of the range,
+-- then (COALESCE(posted_at, created_at), id), all descending.
+CREATE INDEX IF NOT EXISTS idx_jobs_salary_order
+  ON jobs ((COALESCE(salary_max, salary_min, -1)::float8) DESC, (COALESCE(posted_at, created_at)) DESC, id DESC);
+
+COMMIT;
@@ -0,0 +1,40 @@
+BEGIN;
+
+CREATE TABLE IF NOT EXISTS scoring_profiles (
+  id UUID PRIMARY KEY DEFAULT gen_random_uuid(),
+  name TEXT NOT NULL,
+  version INTEGER NOT NULL DEFAULT 1,
+  mandatory_weight NUMERIC(6,2) NOT NULL DEFAULT 60,
+  optional_weight NUMERIC(6,2) NOT NULL DEFAULT 30,
+  experience_weight NUMERIC(6,2) NOT NULL DEFAULT 10,
+  importance_mode TEXT NOT NULL DEFAULT 'flat',
+  partial_credit_curve TEXT NOT NULL DEFAULT 'linear',
+  years_cap NUMERIC(4,2) NOT NULL DEFAULT 1,
+  is_default BOOLEAN NOT NULL DEFAULT false,
+  is_active BOOLEAN NOT NULL DEFAULT true,
+  created_at TIMESTAMPTZ NOT NULL DEFAULT now(),
+  CONSTRAINT uq_scoring_profiles_name_version UNIQUE (name, version),
+  CONSTRAINT chk_scoring_profiles_version CHECK (version >= 1),
+  CONSTRAINT chk_scoring_profiles_weights CHECK (
+    mandatory_weight >= 0 AND optional_weight >= 0 AND experience_weight >= 0
+    AND mandatory_weight + optional_weight + experience_weight > 0
+  ),
+  CONSTRAINT chk_scoring_profiles_importance_mode CHECK (importance_mode IN ('flat', 'linear')),
+  CONSTRAINT chk_scoring_profiles_curve CHECK (partial_credit_curve IN ('linear', 'quadratic', 'sqrt', 'none')),
+  CONSTRAINT chk_scoring_profiles_years_cap CHECK (years_cap > 0)
+);
+
+INSERT INTO scoring_profiles (name, version, mandatory_weight, optional_weight, experience_weight, importance_mode, partial_credit_curve, years_cap, is_default)
+VALUES
+  ('legacy_v1', 1, 60, 30, 10, 'flat', 'linear', 1, false),
+  ('default_v2', 1, 60, 30, 10, 'flat', 'linear', 1, true)
+ON CONFLICT (name, version) DO NOTHING;
+
+ALTER TABLE job_matches
+  ADD COLUMN IF NOT EXISTS scoring_profile TEXT,
+  ADD COLUMN IF NOT EXISTS scoring_version INTEGER;
+
+CREATE INDEX IF NOT EXISTS idx_job_matches_scoring_profile
+  ON job_matches(scoring_profile, scoring_version);
+
+COMMIT;
@@ -0,0 +1,42 @@
+BEGIN;
+
+CREATE TABLE IF NOT EXISTS skill_relations (
+  id UUID PRIMARY KEY DEFAULT gen_random_uuid(),
+  skill_id UUID NOT NULL REFERENCES skills(id) ON DELETE CASCADE,
+  related_skill_id UUID NOT NULL REFERENCES skills(id) ON DELETE CASCADE,
+  relation_type TEXT NOT NULL,
+  credit NUMERIC(4,2) NOT NULL DEFAULT 0.5,
+  created_at TIMESTAMPTZ NOT NULL DEFAULT now(),
+  updated_at TIMESTAMPTZ NOT NULL DEFAULT now(),
+  CONSTRAINT uq_skill_relations UNIQUE (skill_id, related_skill_id, relation_type),
+  CONSTRAINT chk_skill_relations_not_self CHECK (skill_id <> related_skill_id),
+  CONSTRAINT chk_skill_relations_type CHECK (relation_type IN ('parent', 'sibling', 'implies')),
+  CONSTRAINT chk_skill_relations_credit CHECK (credit > 0 AND credit <= 1)
+);
+
+CREATE INDEX IF NOT EXISTS idx_skill_relations_related_skill_id
+  ON skill_relations(related_skill_id);
+
+ALTER TABLE scoring_profiles
+  ADD COLUMN IF NOT EXISTS related_credit NUMERIC(4,2) NOT NULL DEFAULT 0;
+
+DO $$
+BEGIN
+  IF NOT EXISTS (
+    SELECT 1
+    FROM pg_constraint
+    WHERE conname = 'chk_scoring_profiles_related_credit'
+  ) THEN
+    ALTER TABLE scoring_profiles
+      ADD CONSTRAINT chk_scoring_profiles_related_credit
+      CHECK (related_credit >= 0 AND related_credit <= 1);
+  END IF;
+END $$;
+
+UPDATE scoring_profiles SET is_default = false WHERE name = 'default_v2' AND version < 2;
+
+INSERT INTO scoring_profiles (name, version, mandatory_weight, optional_weight, experience_weight, importance_mode, partial_credit_curve, years_cap, related_credit, is_default)
+VALUES ('default_v2', 2, 60, 30, 10, 'flat', 'linear', 1, 1, true)
+ON CONFLICT (name, version) DO NOTHING;
+
+COMMIT;